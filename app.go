@@ -9,30 +9,59 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 
-	tmdb "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
-	tmjson "github.com/cometbft/cometbft/libs/json"
-	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/consensus"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
-
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/maco144/pickle/docs"
+	"github.com/maco144/pickle/x/bondingcurve"
+	bondingcurvekeeper "github.com/maco144/pickle/x/bondingcurve/keeper"
+	bondingcurvetypes "github.com/maco144/pickle/x/bondingcurve/types"
 	"github.com/maco144/pickle/x/workqueue"
 	workqueuekeeper "github.com/maco144/pickle/x/workqueue/keeper"
 	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
@@ -40,23 +69,37 @@ import (
 
 const (
 	Name = "pickle"
+
+	// BondDenom is the denomination validators stake and bond in
+	BondDenom = workqueuetypes.DefaultBondDenom
 )
 
 var (
 	// DefaultNodeHome default home directories for the application
 	DefaultNodeHome string
 
-	// ModuleBasics defines the module BasicManager
+	// ModuleBasics defines the module BasicManager, which registers the
+	// codecs of every module
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
+		genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
 		bank.AppModuleBasic{},
+		staking.AppModuleBasic{},
+		gov.NewAppModuleBasic([]govclient.ProposalHandler{}),
 		params.AppModuleBasic{},
+		consensus.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 		workqueue.AppModuleBasic{},
+		bondingcurve.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName: nil,
+		authtypes.FeeCollectorName:     nil,
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:            {authtypes.Burner},
+		workqueuetypes.ModuleName:      {authtypes.Burner},
 	}
 )
 
@@ -67,136 +110,262 @@ func init() {
 	}
 
 	DefaultNodeHome = filepath.Join(userHomeDir, "."+Name)
+
+	// Staking and the genesis commands default to the chain's bond denom
+	sdk.DefaultBondDenom = BondDenom
 }
 
 // App extends an ABCI application, but with some additional fields
 // to track tendermint commits and module-specific keepers
 type App struct {
 	*baseapp.BaseApp
-	legacyAmino       *codec.LegacyCodec
+	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	// keepers
-	keys map[string]*storetypes.KVStoreKey
-	tKeys map[string]*storetypes.TransientStoreKey
+	keys    map[string]*storetypes.KVStoreKey
+	tKeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
-	AccountKeeper      authkeeper.AccountKeeper
-	BankKeeper         bankkeeper.Keeper
-	ParamsKeeper       paramskeeper.Keeper
-	WorkqueueKeeper    workqueuekeeper.Keeper
+	AccountKeeper         authkeeper.AccountKeeper
+	BankKeeper            bankkeeper.Keeper
+	StakingKeeper         *stakingkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	ParamsKeeper          paramskeeper.Keeper
+	ConsensusParamsKeeper consensuskeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
+	WorkqueueKeeper       workqueuekeeper.Keeper
+	BondingCurveKeeper    bondingcurvekeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
 
+	// BasicModuleManager is the basic manager of the modules of the module
+	// manager, whose CLI commands are built with the app's codecs
+	BasicModuleManager module.BasicManager
+
 	// the configurator
 	configurator module.Configurator
+
+	// haltUpgrade is the upgrade operators halted the chain to apply, read
+	// from the upgrade info on disk
+	haltUpgrade upgradetypes.Plan
 }
 
 // NewApp returns a reference to an initialized Pickle application.
 func NewApp(
 	logger log.Logger,
-	db tmdb.DB,
+	db dbm.DB,
 	traceStore io.Writer,
 	loadLatest bool,
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *App {
 	// Setup codec
-	var cdc codec.Codec
-	interfaceRegistry := types.NewInterfaceRegistry()
-	cdc = codec.NewProtoCodec(interfaceRegistry)
-	legacyAmino := codec.NewLegacyAmino()
-
-	// Register module codecs
-	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+	encodingConfig := MakeEncodingConfig()
+	cdc := encodingConfig.Codec
+	txConfig := encodingConfig.TxConfig
 
 	// Create base app
-	bApp := baseapp.NewBaseApp(Name, logger, db, nil, append(
-		[]func(*baseapp.BaseApp){
-			baseapp.SetChainID("pickle-1"),
-		},
-		baseAppOptions...,
-	)...)
+	bApp := baseapp.NewBaseApp(Name, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
+	bApp.SetVersion(version.Version)
+	bApp.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	bApp.SetTxEncoder(txConfig.TxEncoder())
 
 	// Declare store keys
-	keys := sdk.NewKVStoreKeys(
+	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey,
 		banktypes.StoreKey,
+		stakingtypes.StoreKey,
+		govtypes.StoreKey,
 		paramstypes.StoreKey,
+		consensustypes.StoreKey,
+		upgradetypes.StoreKey,
 		workqueuetypes.StoreKey,
+		bondingcurvetypes.StoreKey,
 	)
 
-	tKeys := sdk.NewTransientStoreKeys(
+	tKeys := storetypes.NewTransientStoreKeys(
 		paramstypes.TStoreKey,
 	)
 
-	memKeys := sdk.NewMemoryStoreKeys(
+	memKeys := storetypes.NewMemoryStoreKeys(
 		workqueuetypes.MemStoreKey,
 	)
 
 	// Create app
 	app := &App{
 		BaseApp:           bApp,
-		legacyAmino:       legacyAmino,
+		legacyAmino:       encodingConfig.Amino,
 		appCodec:          cdc,
-		interfaceRegistry: interfaceRegistry,
+		interfaceRegistry: encodingConfig.InterfaceRegistry,
+		txConfig:          txConfig,
 		keys:              keys,
 		tKeys:             tKeys,
 		memKeys:           memKeys,
 	}
 
+	// Params, work types and upgrades are changed by governance proposals,
+	// which the gov module account executes
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	// Initialize keepers
 	app.ParamsKeeper = paramskeeper.NewKeeper(
 		cdc,
-		legacyAmino,
+		encodingConfig.Amino,
 		keys[paramstypes.StoreKey],
 		tKeys[paramstypes.TStoreKey],
 	)
 
+	app.ConsensusParamsKeeper = consensuskeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[consensustypes.StoreKey]),
+		authority,
+		runtime.EventService{},
+	)
+	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
+
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		cdc,
-		keys[authtypes.StoreKey],
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
 		authtypes.ProtoBaseAccount,
 		maccPerms,
+		address.NewBech32Codec(sdk.Bech32MainPrefix),
 		sdk.Bech32MainPrefix,
-		"pickle",
+		authority,
 	)
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		cdc,
-		keys[banktypes.StoreKey],
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		app.AccountKeeper,
+		BlockedAddresses(),
+		authority,
+		logger,
+	)
+
+	app.StakingKeeper = stakingkeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		authority,
+		address.NewBech32Codec(sdk.Bech32PrefixValAddr),
+		address.NewBech32Codec(sdk.Bech32PrefixConsAddr),
+	)
+
+	// Without a distribution module, proposal cancellation charges are burned
+	// or sent to an account, never to the community pool
+	app.GovKeeper = govkeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[govtypes.StoreKey]),
 		app.AccountKeeper,
-		map[string]bool{},
-		"pickle",
+		app.BankKeeper,
+		app.StakingKeeper,
+		nil,
+		app.MsgServiceRouter(),
+		govtypes.DefaultConfig(),
+		authority,
+	)
+
+	// Legacy v1beta1 proposals are limited to text proposals
+	govRouter := govv1beta1.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler)
+	app.GovKeeper.SetLegacyRouter(govRouter)
+
+	// Upgrades are skipped at the heights given with --unsafe-skip-upgrades
+	skipUpgradeHeights := map[int64]bool{}
+	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
+		skipUpgradeHeights[int64(h)] = true
+	}
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(
+		skipUpgradeHeights,
+		runtime.NewKVStoreService(keys[upgradetypes.StoreKey]),
+		cdc,
+		cast.ToString(appOpts.Get(flags.FlagHome)),
+		app.BaseApp,
+		authority,
 	)
 
 	app.WorkqueueKeeper = workqueuekeeper.NewKeeper(
 		cdc,
 		keys[workqueuetypes.StoreKey],
 		memKeys[workqueuetypes.MemStoreKey],
+		app.BankKeeper,
+		authority,
+	)
+	// x/crisis is not wired, so the invariants run only when queried
+	if cast.ToBool(appOpts.Get(workqueue.FlagEnableInvariantsQuery)) {
+		app.WorkqueueKeeper.EnableInvariantsQuery()
+	}
+
+	app.BondingCurveKeeper = bondingcurvekeeper.NewKeeper(
+		cdc,
+		keys[bondingcurvetypes.StoreKey],
+		authority,
+	)
+
+	// Register modules reacting to workqueue state changes
+	app.WorkqueueKeeper.SetHooks(
+		workqueuetypes.NewMultiWorkqueueHooks(
+			app.BondingCurveKeeper.Hooks(),
+		),
 	)
 
 	// Create module manager
 	app.mm = module.NewManager(
+		genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app, txConfig),
 		auth.NewAppModule(cdc, app.AccountKeeper, nil, nil),
 		bank.NewAppModule(cdc, app.BankKeeper, app.AccountKeeper, nil),
+		staking.NewAppModule(cdc, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, nil),
+		gov.NewAppModule(cdc, app.GovKeeper, app.AccountKeeper, app.BankKeeper, nil),
 		params.NewAppModule(app.ParamsKeeper),
+		consensus.NewAppModule(cdc, app.ConsensusParamsKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		workqueue.NewAppModule(cdc, app.WorkqueueKeeper),
+		bondingcurve.NewAppModule(cdc, app.BondingCurveKeeper),
+	)
+
+	// The genutil basic module validates gentxs, which its app module does
+	// not carry
+	app.BasicModuleManager = module.NewBasicManagerFromManager(
+		app.mm,
+		map[string]module.AppModuleBasic{
+			genutiltypes.ModuleName: genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
+		},
 	)
 
 	// Set module order
-	app.mm.SetOrderBeginBlockers()
+	app.mm.SetOrderPreBlockers(
+		upgradetypes.ModuleName,
+	)
 
-	app.mm.SetOrderEndBlockers()
+	app.mm.SetOrderBeginBlockers(
+		stakingtypes.ModuleName,
+	)
+
+	app.mm.SetOrderEndBlockers(
+		govtypes.ModuleName,
+		stakingtypes.ModuleName,
+		workqueuetypes.ModuleName,
+	)
 
+	// Genesis transactions are delivered once accounts, balances and staking
+	// are initialized
 	app.mm.SetOrderInitGenesis(
 		authtypes.ModuleName,
 		banktypes.ModuleName,
+		stakingtypes.ModuleName,
+		genutiltypes.ModuleName,
+		govtypes.ModuleName,
 		paramstypes.ModuleName,
+		consensustypes.ModuleName,
+		upgradetypes.ModuleName,
 		workqueuetypes.ModuleName,
+		bondingcurvetypes.ModuleName,
 	)
 
 	// Register upgrade handlers
@@ -209,15 +378,27 @@ func NewApp(
 
 	// Initialize the app
 	app.SetInitChainer(app.InitChainer)
+	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Set antehandler
-	app.SetAnteHandler(nil)
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		SignModeHandler: txConfig.SignModeHandler(),
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	})
+	if err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(anteHandler)
 
 	// Create the configurator
 	app.configurator = module.NewConfigurator(cdc, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	app.mm.RegisterServices(app.configurator)
+	if err := app.mm.RegisterServices(app.configurator); err != nil {
+		panic(err)
+	}
 
 	// Load latest version
 	if loadLatest {
@@ -226,38 +407,57 @@ func NewApp(
 		}
 	}
 
-	// Initialize and seal capabilities
-	app.Seal()
-
 	return app
 }
 
 // Name returns the name of the App
 func (app *App) Name() string { return Name }
 
+// PreBlocker application updates before each begin block, applying any
+// upgrade scheduled for the block or that the chain was halted for
+func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	applied, err := app.applyHaltUpgrade(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Migrations may change the consensus params
+	res.ConsensusParamsChanged = res.ConsensusParamsChanged || applied
+	return res, nil
+}
+
 // BeginBlocker application updates every begin block
-func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) error {
-	return app.mm.BeginBlock(ctx, req)
+func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	return app.mm.BeginBlock(ctx)
 }
 
 // EndBlocker application updates every end block
-func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ([]abci.ValidatorUpdate, error) {
-	return app.mm.EndBlock(ctx, req)
+func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	return app.mm.EndBlock(ctx)
 }
 
 // InitChainer application update at chain initialization
-func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		return nil, err
+	}
 
-	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
-		panic(err)
+	// The version map lets upgrade handlers run only the migrations a chain
+	// started at genesis needs
+	if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap()); err != nil {
+		return nil, err
 	}
 
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-// LegacyAmino returns SimApp's amino codec.
-func (app *App) LegacyAmino() *codec.LegacyCodec {
+// LegacyAmino returns Pickle's amino codec.
+func (app *App) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
 
@@ -271,15 +471,38 @@ func (app *App) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// TxConfig returns Pickle's TxConfig
+func (app *App) TxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // Configurator returns the configurator
 func (app *App) Configurator() module.Configurator {
 	return app.configurator
 }
 
+// DefaultGenesis returns the default genesis state of every module
+func (app *App) DefaultGenesis() map[string]json.RawMessage {
+	return app.BasicModuleManager.DefaultGenesis(app.appCodec)
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
-	// This is handled by the SDK's built-in routing
+	clientCtx := apiSvr.ClientCtx
+
+	// Register the tx, node and CometBFT routes of the gRPC gateway
+	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	cmtservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register the module query routes of the gRPC gateway
+	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Serve the OpenAPI document of those routes when swagger is enabled
+	if apiConfig.Swagger {
+		docs.RegisterOpenAPIService(apiSvr.Router)
+	}
 }
 
 // GetKey returns the KVStoreKey for the provided store key.
@@ -297,39 +520,65 @@ func (app *App) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
 	return app.memKeys[storeKey]
 }
 
-// registerUpgradeHandlers registers upgrade handlers for the app
-func (app *App) registerUpgradeHandlers() {
-	// Upgrade handlers would go here
-}
-
 // RegisterTxService registers the tx service for the app
 func (app *App) RegisterTxService(clientCtx client.Context) {
-	// Tx service registration
+	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
 }
 
 // RegisterTendermintService registers the tendermint service for the app
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
-	// Tendermint service registration
+	cmtservice.RegisterTendermintService(clientCtx, app.GRPCQueryRouter(), app.interfaceRegistry, app.Query)
+}
+
+// RegisterNodeService registers the node service for the app
+func (app *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
 
 // ExportAppStateAndValidators exports the state of the application for a genesis file.
 func (app *App) ExportAppStateAndValidators(
 	forZeroHeight bool,
-	jailAllowedAddrs []string,
-) (json.RawMessage, []tmtypes.GenesisValidator, error) {
+	_ []string,
+	modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	// Work deadlines, leases and reveal phases are block heights, which a
+	// chain restarting from height zero would misread
+	if forZeroHeight {
+		return servertypes.ExportedApp{}, fmt.Errorf("%s state cannot be exported for zero height", workqueuetypes.ModuleName)
+	}
+
 	ctx := app.NewContext(true)
 
 	// Export genesis state
-	genesisState := app.mm.ExportGenesisForModules(
-		ctx,
-		app.appCodec,
-		[]string{},
-	)
+	genesisState, err := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
 
 	appState, err := json.MarshalIndent(genesisState, "", "  ")
 	if err != nil {
-		return nil, nil, err
+		return servertypes.ExportedApp{}, err
 	}
 
-	return appState, []tmtypes.GenesisValidator{}, nil
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          app.LastBlockHeight() + 1,
+		ConsensusParams: app.GetConsensusParams(ctx),
+	}, nil
+}
+
+// BlockedAddresses returns the module accounts that cannot receive funds
+// through bank sends
+func BlockedAddresses() map[string]bool {
+	blocked := make(map[string]bool, len(maccPerms))
+	for acc := range maccPerms {
+		blocked[authtypes.NewModuleAddress(acc).String()] = true
+	}
+	return blocked
 }
@@ -0,0 +1,352 @@
+package pickle_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	pickle "github.com/maco144/pickle"
+	"github.com/maco144/pickle/docs"
+	bondingcurvetypes "github.com/maco144/pickle/x/bondingcurve/types"
+	"github.com/maco144/pickle/x/workqueue"
+	workqueuekeeper "github.com/maco144/pickle/x/workqueue/keeper"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+// funder is the genesis account funding the accounts of a test
+var funder = sdk.AccAddress("funder______________")
+
+// setupApp returns an app initialized from the default genesis of every
+// module and a single validator, with a context on top of its first committed block
+func setupApp(t *testing.T) (*pickle.App, sdk.Context) {
+	t.Helper()
+
+	opts := viper.New()
+	opts.Set(flags.FlagHome, t.TempDir())
+	return setupAppWithOptions(t, opts)
+}
+
+// setupAppWithOptions sets up an app as setupApp does, with the given node
+// options
+func setupAppWithOptions(t *testing.T, opts *viper.Viper) (*pickle.App, sdk.Context) {
+	t.Helper()
+
+	app := pickle.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, opts, baseapp.SetChainID("pickle-test"))
+
+	// The chain needs a validator to start, bonded by a funded account
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	if err != nil {
+		t.Fatalf("failed to create validator set: %v", err)
+	}
+	account := authtypes.NewBaseAccountWithAddress(funder)
+	balance := banktypes.Balance{
+		Address: account.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(pickle.BondDenom, 100_000_000_000_000)),
+	}
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{account}, balance)
+	if err != nil {
+		t.Fatalf("failed to build genesis: %v", err)
+	}
+
+	appState, err := json.Marshal(genesisState)
+	if err != nil {
+		t.Fatalf("failed to marshal default genesis: %v", err)
+	}
+	if _, err := app.InitChain(&abci.RequestInitChain{ChainId: "pickle-test", AppStateBytes: appState}); err != nil {
+		t.Fatalf("init chain failed: %v", err)
+	}
+	if _, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1}); err != nil {
+		t.Fatalf("finalize block failed: %v", err)
+	}
+	if _, err := app.Commit(); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+
+	return app, app.NewUncachedContext(false, cmtproto.Header{ChainID: "pickle-test", Height: 2})
+}
+
+// fund sends each account an amount of the bond denom from the funder
+func fund(t *testing.T, app *pickle.App, ctx sdk.Context, amount int64, accounts ...sdk.AccAddress) {
+	t.Helper()
+
+	for _, account := range accounts {
+		if err := app.BankKeeper.SendCoins(ctx, funder, account, sdk.NewCoins(sdk.NewInt64Coin(pickle.BondDenom, amount))); err != nil {
+			t.Fatalf("failed to fund %s: %v", account, err)
+		}
+	}
+}
+
+// passProposal submits msg as a governance proposal, as the authority-gated
+// messages of every module are, then deposits and votes it through with the
+// funder's stake and ends its voting period, which executes it
+func passProposal(t *testing.T, app *pickle.App, ctx sdk.Context, msg sdk.Msg) {
+	t.Helper()
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		t.Fatalf("failed to read the governance params: %v", err)
+	}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", funder, false)
+	if err != nil {
+		t.Fatalf("governance rejected the proposal: %v", err)
+	}
+
+	// The funder delegates all of the validator's stake
+	govMsgServer := govkeeper.NewMsgServerImpl(app.GovKeeper)
+	if _, err := govMsgServer.Deposit(ctx, govv1.NewMsgDeposit(funder, proposal.Id, params.MinDeposit)); err != nil {
+		t.Fatalf("failed to deposit on the proposal: %v", err)
+	}
+	if _, err := govMsgServer.Vote(ctx, govv1.NewMsgVote(funder, proposal.Id, govv1.OptionYes, "")); err != nil {
+		t.Fatalf("failed to vote on the proposal: %v", err)
+	}
+
+	if err := gov.EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(*params.VotingPeriod)), app.GovKeeper); err != nil {
+		t.Fatalf("governance end blocker failed: %v", err)
+	}
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	if err != nil {
+		t.Fatalf("failed to read the proposal: %v", err)
+	}
+	if proposal.Status != govv1.StatusPassed {
+		t.Fatalf("proposal to run %s ended %s: %s", sdk.MsgTypeURL(msg), proposal.Status, proposal.FailedReason)
+	}
+}
+
+func TestGovernanceUpdatesWorkqueueParams(t *testing.T) {
+	app, ctx := setupApp(t)
+
+	params := workqueuetypes.DefaultParams()
+	params.ChallengeWindow = 42
+	passProposal(t, app, ctx, &workqueuetypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+
+	if window := app.WorkqueueKeeper.GetParams(ctx).ChallengeWindow; window != 42 {
+		t.Fatalf("challenge window is %d, want 42", window)
+	}
+}
+
+func TestGovernanceReshapesBondingCurve(t *testing.T) {
+	app, ctx := setupApp(t)
+
+	params := bondingcurvetypes.DefaultParams()
+	params.Shape = bondingcurvetypes.ShapeSigmoid
+	passProposal(t, app, ctx, &bondingcurvetypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+
+	if shape := app.BondingCurveKeeper.GetParams(ctx).Shape; shape != bondingcurvetypes.ShapeSigmoid {
+		t.Fatalf("curve shape is %s, want %s", shape, bondingcurvetypes.ShapeSigmoid)
+	}
+}
+
+func TestGovernanceRegistersWorkTypes(t *testing.T) {
+	app, ctx := setupApp(t)
+
+	passProposal(t, app, ctx, &workqueuetypes.MsgSetWorkTypeDefinition{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Definition: &workqueuetypes.WorkTypeDefinition{
+			Name:       "genomics",
+			Schema:     `{"type":"object","required":["sequence"]}`,
+			QuorumRule: workqueuetypes.DefaultQuorumRule("genomics"),
+			Enabled:    true,
+		},
+	})
+
+	definition, found := app.WorkqueueKeeper.GetWorkTypeDefinition(ctx, "genomics")
+	if !found || !definition.Enabled {
+		t.Fatalf("work type definition is %v, want an enabled genomics definition", definition)
+	}
+}
+
+func TestGovernanceSchedulesUpgrades(t *testing.T) {
+	app, ctx := setupApp(t)
+
+	passProposal(t, app, ctx, &upgradetypes.MsgSoftwareUpgrade{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Plan:      upgradetypes.Plan{Name: pickle.UpgradeNameV4, Height: 100},
+	})
+
+	plan, err := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	if err != nil {
+		t.Fatalf("no upgrade plan was scheduled: %v", err)
+	}
+	if plan.Name != pickle.UpgradeNameV4 || plan.Height != 100 {
+		t.Fatalf("upgrade plan is %s at %d, want %s at 100", plan.Name, plan.Height, pickle.UpgradeNameV4)
+	}
+	for _, name := range []string{pickle.UpgradeNameV2, pickle.UpgradeNameV3, pickle.UpgradeNameV4} {
+		if !app.UpgradeKeeper.HasHandler(name) {
+			t.Fatalf("no upgrade handler is registered for %s", name)
+		}
+	}
+}
+
+func TestHaltUpgradeAppliesAtItsHeight(t *testing.T) {
+	// Operators halted the chain before height 2 to apply v3
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "data"), 0o755); err != nil {
+		t.Fatalf("failed to create the data directory: %v", err)
+	}
+	upgradeInfo := fmt.Sprintf(`{"name":%q,"height":2}`, pickle.UpgradeNameV3)
+	if err := os.WriteFile(filepath.Join(home, "data", upgradetypes.UpgradeInfoFilename), []byte(upgradeInfo), 0o600); err != nil {
+		t.Fatalf("failed to write the upgrade info: %v", err)
+	}
+	opts := viper.New()
+	opts.Set(flags.FlagHome, home)
+	app, ctx := setupAppWithOptions(t, opts)
+	if height, _ := app.UpgradeKeeper.GetDoneHeight(ctx, pickle.UpgradeNameV3); height != 0 {
+		t.Fatalf("upgrade was applied at %d before its height", height)
+	}
+
+	if _, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2}); err != nil {
+		t.Fatalf("finalize block failed: %v", err)
+	}
+	if _, err := app.Commit(); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	ctx = app.NewUncachedContext(false, cmtproto.Header{ChainID: "pickle-test", Height: 3})
+	if name, height, _ := app.UpgradeKeeper.GetLastCompletedUpgrade(ctx); name != pickle.UpgradeNameV3 || height != 2 {
+		t.Fatalf("last completed upgrade is %q at %d, want %s at 2", name, height, pickle.UpgradeNameV3)
+	}
+}
+
+func TestNodeFlagEnablesInvariantsQuery(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		opts := viper.New()
+		opts.Set(flags.FlagHome, t.TempDir())
+		opts.Set(workqueue.FlagEnableInvariantsQuery, enabled)
+		app, ctx := setupAppWithOptions(t, opts)
+
+		_, err := workqueuekeeper.NewQueryServerImpl(app.WorkqueueKeeper).CheckInvariants(ctx, &workqueuetypes.QueryCheckInvariantsRequest{})
+		if served := err == nil; served != enabled {
+			t.Fatalf("node with the invariants query enabled %t served it %t: %v", enabled, served, err)
+		}
+	}
+}
+
+func TestOverturnMovesBondingCurveBack(t *testing.T) {
+	app, ctx := setupApp(t)
+	k := app.WorkqueueKeeper
+
+	params := k.GetParams(ctx)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	challenger := sdk.AccAddress("challenger__________")
+	fund(t, app, ctx, 100_000_000, alice, bob, challenger)
+	for _, validator := range []sdk.AccAddress{alice, bob} {
+		if err := k.Bond(ctx, validator.String(), params.MinValidatorBondCoin()); err != nil {
+			t.Fatalf("failed to bond %s: %v", validator, err)
+		}
+	}
+
+	work := &workqueuetypes.WorkUnit{Type: workqueuetypes.WorkTypeCrypto, Data: []byte(`{"block":1}`), Submitter: challenger.String()}
+	if err := k.SubmitWork(ctx, work); err != nil {
+		t.Fatalf("failed to submit work: %v", err)
+	}
+	vote := func(validator sdk.AccAddress, valid bool) {
+		t.Helper()
+		if _, err := k.ClaimWork(ctx, work.Id, validator.String()); err != nil {
+			t.Fatalf("%s failed to claim work: %v", validator, err)
+		}
+		if err := k.ValidateWork(ctx, work.Id, validator.String(), valid, 90, "proof"); err != nil {
+			t.Fatalf("%s failed to vote: %v", validator, err)
+		}
+	}
+	unitsValidated := func() uint64 {
+		t.Helper()
+		state, err := app.BondingCurveKeeper.GetState(ctx)
+		if err != nil {
+			t.Fatalf("failed to read the curve: %v", err)
+		}
+		return state.TotalUnitsValidated
+	}
+
+	// Validation moves the curve forward through the workqueue hooks
+	vote(alice, true)
+	if units := unitsValidated(); units != 1 {
+		t.Fatalf("curve counts %d validated units after validation, want 1", units)
+	}
+
+	// An overturn to rejected moves it back
+	if err := k.ChallengeWork(ctx, challenger.String(), work.Id, params.MinChallengeBondCoin(), "wrong"); err != nil {
+		t.Fatalf("failed to challenge work: %v", err)
+	}
+	vote(bob, false)
+	if stored, _ := k.GetWork(ctx, work.Id); stored.Status != workqueuetypes.WorkStatusRejected {
+		t.Fatalf("work is %s after the challenge, want rejected", stored.Status)
+	}
+	if units := unitsValidated(); units != 0 {
+		t.Fatalf("curve counts %d validated units after the overturn, want 0", units)
+	}
+	if units := k.GetTotalWorkValidated(ctx); units != 0 {
+		t.Fatalf("workqueue counts %d validated units after the overturn, want 0", units)
+	}
+}
+
+func TestAPIRoutesServeModuleQueriesAndOpenAPI(t *testing.T) {
+	app, _ := setupApp(t)
+
+	clientCtx := client.Context{}.WithCodec(app.AppCodec()).WithInterfaceRegistry(app.InterfaceRegistry())
+	apiSvr := api.New(clientCtx, log.NewNopLogger(), nil)
+	app.RegisterAPIRoutes(apiSvr, config.APIConfig{Swagger: true})
+
+	serve := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	// Without a node to query, the gateway fails the request of a registered
+	// route, which differs from how it answers a route it does not serve
+	unknown := serve(apiSvr.GRPCGatewayRouter, "/pickle/workqueue/v1/unknown").Code
+	routes := []string{"/pickle/workqueue/v1/params", "/pickle/bondingcurve/v1/params", "/pickle/bondingcurve/v1/price"}
+	for _, route := range routes {
+		if code := serve(apiSvr.GRPCGatewayRouter, route).Code; code == unknown {
+			t.Fatalf("%s answered %d like an unknown route", route, code)
+		}
+	}
+
+	recorder := serve(apiSvr.Router, docs.OpenAPIRoute)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("OpenAPI document answered %d, want %d", recorder.Code, http.StatusOK)
+	}
+	var spec struct {
+		Paths map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("OpenAPI document is not JSON: %v", err)
+	}
+	for _, route := range routes {
+		if _, ok := spec.Paths[route]; !ok {
+			t.Fatalf("OpenAPI document does not describe %s", route)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/pruning"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+
+	pickle "github.com/maco144/pickle"
+	"github.com/maco144/pickle/x/workqueue"
+)
+
+// initRootCmd adds the node, genesis, key, query and tx commands to the root
+// command
+func initRootCmd(rootCmd *cobra.Command, encodingConfig pickle.EncodingConfig, basicManager module.BasicManager) {
+	rootCmd.AddCommand(
+		genutilcli.InitCmd(basicManager, pickle.DefaultNodeHome),
+		debug.Cmd(),
+		pruning.Cmd(newApp, pickle.DefaultNodeHome),
+		snapshot.Cmd(newApp),
+	)
+
+	server.AddCommandsWithStartCmdOptions(rootCmd, pickle.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
+		AddFlags: workqueue.AddModuleInitFlags,
+	})
+	if exportCmd, _, err := rootCmd.Find([]string{"export"}); err == nil {
+		exportCmd.PreRunE = rejectZeroHeightExport
+	}
+
+	rootCmd.AddCommand(
+		server.StatusCommand(),
+		genutilcli.Commands(encodingConfig.TxConfig, basicManager, pickle.DefaultNodeHome),
+		queryCommand(basicManager),
+		txCommand(basicManager),
+		keys.Commands(),
+	)
+}
+
+// queryCommand returns the query command with the block and tx queries and
+// the query commands of every module
+func queryCommand(basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "query",
+		Aliases:                    []string{"q"},
+		Short:                      "Querying subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		rpc.ValidatorCommand(),
+		server.QueryBlockCmd(),
+		server.QueryBlocksCmd(),
+		authcli.QueryTxsByEventsCmd(),
+		authcli.QueryTxCmd(),
+	)
+
+	basicManager.AddQueryCommands(cmd)
+
+	return cmd
+}
+
+// txCommand returns the tx command with the signing and broadcasting commands
+// and the tx commands of every module
+func txCommand(basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "tx",
+		Short:                      "Transactions subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		authcli.GetSignCommand(),
+		authcli.GetSignBatchCommand(),
+		authcli.GetMultiSignCommand(),
+		authcli.GetMultiSignBatchCmd(),
+		authcli.GetValidateSignaturesCommand(),
+		authcli.GetBroadcastCommand(),
+		authcli.GetEncodeCommand(),
+		authcli.GetDecodeCommand(),
+		authcli.GetSimulateCmd(),
+	)
+
+	basicManager.AddTxCommands(cmd)
+
+	return cmd
+}
+
+// rejectZeroHeightExport refuses an export for a chain restarting at zero
+// height before the node's data is opened. Work deadlines, leases and reveal
+// phases are block heights, so the app cannot produce such an export.
+func rejectZeroHeightExport(cmd *cobra.Command, _ []string) error {
+	if forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight); forZeroHeight {
+		return fmt.Errorf("--%s is not supported: work deadlines, leases and reveal phases are block heights that a chain restarting from zero would misread", server.FlagForZeroHeight)
+	}
+	return nil
+}
+
+// newApp creates the app of a node
+func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	return pickle.NewApp(logger, db, traceStore, true, appOpts, server.DefaultBaseappOptions(appOpts)...)
+}
+
+// appExport exports the state of the app at a height, or at the latest height
+// when it is -1
+func appExport(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	forZeroHeight bool,
+	jailAllowedAddrs []string,
+	appOpts servertypes.AppOptions,
+	modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	app := pickle.NewApp(logger, db, traceStore, height == -1, appOpts)
+	if height != -1 {
+		if err := app.LoadVersion(height); err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("failed to load height %d: %w", height, err)
+		}
+	}
+
+	return app.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
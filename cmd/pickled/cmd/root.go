@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"os"
+
+	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	pickle "github.com/maco144/pickle"
+)
+
+// NewRootCmd creates the root command of pickled, with the client context,
+// keyring and server configuration every subcommand reads
+func NewRootCmd() *cobra.Command {
+	encodingConfig := pickle.MakeEncodingConfig()
+
+	// The module CLI commands are built with the codecs of an app, so a
+	// throwaway one provides the basic module manager
+	tempApp := pickle.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, tempAppOptions())
+
+	initClientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithInput(os.Stdin).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithHomeDir(pickle.DefaultNodeHome).
+		WithViper("")
+
+	rootCmd := &cobra.Command{
+		Use:           "pickled",
+		Short:         "Pickle - Data Preservation Engine",
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			// Set the default command outputs
+			cmd.SetOut(cmd.OutOrStdout())
+			cmd.SetErr(cmd.ErrOrStderr())
+
+			initClientCtx = initClientCtx.WithCmdContext(cmd.Context())
+			initClientCtx, err := client.ReadPersistentCommandFlags(initClientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			initClientCtx, err = config.ReadFromClientConfig(initClientCtx)
+			if err != nil {
+				return err
+			}
+
+			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
+				return err
+			}
+
+			customAppTemplate, customAppConfig := initAppConfig()
+			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig, cmtcfg.DefaultConfig())
+		},
+	}
+
+	initRootCmd(rootCmd, encodingConfig, tempApp.BasicModuleManager)
+
+	return rootCmd
+}
+
+// initAppConfig returns the app config of a new node, which accepts
+// transactions paying no fees in the bond denom
+func initAppConfig() (string, interface{}) {
+	srvCfg := serverconfig.DefaultConfig()
+	srvCfg.MinGasPrices = "0" + pickle.BondDenom
+
+	return serverconfig.DefaultConfigTemplate, srvCfg
+}
+
+// tempAppOptions returns the options of the app built for its basic module
+// manager, whose home does not exist so that no upgrade info is read
+func tempAppOptions() *viper.Viper {
+	dir, err := os.MkdirTemp("", "pickled")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		panic(err)
+	}
+
+	opts := viper.New()
+	opts.Set(flags.FlagHome, dir)
+	return opts
+}
@@ -0,0 +1,119 @@
+package cmd_test
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	pickle "github.com/maco144/pickle"
+	"github.com/maco144/pickle/cmd/pickled/cmd"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+// execute runs pickled with the given arguments against a node home,
+// discarding its output
+func execute(t *testing.T, home string, args ...string) error {
+	t.Helper()
+
+	rootCmd := cmd.NewRootCmd()
+	rootCmd.SetArgs(append(args, "--home", home))
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	return svrcmd.Execute(rootCmd, "", home)
+}
+
+// startChain initializes the chain of a node home from its genesis file and
+// commits the first block, as starting the node would
+func startChain(t *testing.T, home string) {
+	t.Helper()
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(home, "config", "genesis.json"))
+	if err != nil {
+		t.Fatalf("failed to read the genesis file: %v", err)
+	}
+	consensus, err := appGenesis.ToGenesisDoc()
+	if err != nil {
+		t.Fatalf("failed to read the consensus genesis: %v", err)
+	}
+
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"), nil)
+	if err != nil {
+		t.Fatalf("failed to open the app database: %v", err)
+	}
+	defer db.Close()
+
+	opts := viper.New()
+	opts.Set(flags.FlagHome, home)
+	app := pickle.NewApp(log.NewNopLogger(), db, nil, true, opts, baseapp.SetChainID(appGenesis.ChainID))
+	consensusParams := consensus.ConsensusParams.ToProto()
+	if _, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   appGenesis.InitialHeight,
+	}); err != nil {
+		t.Fatalf("failed to init the chain: %v", err)
+	}
+	if _, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: appGenesis.InitialHeight}); err != nil {
+		t.Fatalf("failed to finalize the first block: %v", err)
+	}
+	if _, err := app.Commit(); err != nil {
+		t.Fatalf("failed to commit the first block: %v", err)
+	}
+}
+
+func TestInitAndExport(t *testing.T) {
+	home := t.TempDir()
+	// Set up a single-validator chain as scripts/testnet.sh does
+	for _, args := range [][]string{
+		{"init", "smoke", "--chain-id", "pickle-test"},
+		{"keys", "add", "validator", "--keyring-backend", "test"},
+		{"genesis", "add-genesis-account", "validator", "1000000000" + pickle.BondDenom, "--keyring-backend", "test"},
+		{"genesis", "gentx", "validator", "100000000" + pickle.BondDenom, "--chain-id", "pickle-test", "--keyring-backend", "test"},
+		{"genesis", "collect-gentxs"},
+	} {
+		if err := execute(t, home, args...); err != nil {
+			t.Fatalf("failed to run %v: %v", args, err)
+		}
+	}
+	startChain(t, home)
+
+	exported := filepath.Join(home, "exported.json")
+	if err := execute(t, home, "export", "--output-document", exported); err != nil {
+		t.Fatalf("failed to export the node: %v", err)
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(exported)
+	if err != nil {
+		t.Fatalf("failed to read the exported genesis: %v", err)
+	}
+	if appGenesis.ChainID != "pickle-test" || appGenesis.InitialHeight != 2 {
+		t.Fatalf("exported chain %q starts at height %d, want pickle-test at height 2", appGenesis.ChainID, appGenesis.InitialHeight)
+	}
+	if validators := appGenesis.Consensus.Validators; len(validators) != 1 {
+		t.Fatalf("exported %d validators, want 1", len(validators))
+	}
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		t.Fatalf("failed to read the exported app state: %v", err)
+	}
+	if _, ok := appState[workqueuetypes.ModuleName]; !ok {
+		t.Fatalf("exported app state holds no %s state", workqueuetypes.ModuleName)
+	}
+
+	// Exports for a zero height restart are refused with the reason
+	err = execute(t, home, "export", "--for-zero-height", "--output-document", filepath.Join(home, "zero.json"))
+	if err == nil || !strings.Contains(err.Error(), "--for-zero-height is not supported") {
+		t.Fatalf("exporting for zero height returned %v, want it refused", err)
+	}
+}
@@ -1,24 +1,20 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
-	"github.com/cosmos/cosmos-sdk/server"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 
 	pickle "github.com/maco144/pickle"
+	"github.com/maco144/pickle/cmd/pickled/cmd"
 )
 
 func main() {
-	rootCmd, _ := svrcmd.CreateRootCommand(
-		pickle.Name,
-		"Pickle - Data Preservation Engine",
-		pickle.NewApp,
-		svrcmd.DefaultServerConfigurator(),
-	)
+	rootCmd := cmd.NewRootCmd()
 
 	if err := svrcmd.Execute(rootCmd, "", pickle.DefaultNodeHome); err != nil {
-		server.PrintErr(err)
+		fmt.Fprintln(rootCmd.OutOrStderr(), err)
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,26 @@
+// Package docs serves the OpenAPI document describing the REST routes of the
+// chain's modules
+package docs
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// OpenAPIRoute is the API server route the OpenAPI document is served at
+const OpenAPIRoute = "/openapi.json"
+
+// openAPI is the OpenAPI document generated from the module query services
+//
+//go:embed static/openapi.json
+var openAPI []byte
+
+// RegisterOpenAPIService serves the OpenAPI document on the API server router
+func RegisterOpenAPIService(rtr *mux.Router) {
+	rtr.HandleFunc(OpenAPIRoute, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPI)
+	}).Methods(http.MethodGet)
+}
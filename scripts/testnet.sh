@@ -0,0 +1,41 @@
+#!/usr/bin/env bash
+# Starts a single-validator testnet from a fresh home: funds a validator and a
+# user key, registers an example work type accepting any data and runs the node.
+set -euo pipefail
+
+BINARY=${BINARY:-./bin/pickled}
+CHAIN_HOME=${CHAIN_HOME:-$HOME/.pickle-testnet}
+CHAIN_ID=${CHAIN_ID:-pickle-testnet-1}
+WORK_TYPE=${WORK_TYPE:-record}
+DENOM=upickle
+
+pickled() {
+	"$BINARY" --home "$CHAIN_HOME" "$@"
+}
+
+rm -rf "$CHAIN_HOME"
+pickled init testnode --chain-id "$CHAIN_ID" >/dev/null 2>&1
+
+# Transactions sent against this home default to its chain and test keyring
+sed -i.bak \
+	-e "s/^chain-id = .*/chain-id = \"$CHAIN_ID\"/" \
+	-e 's/^keyring-backend = .*/keyring-backend = "test"/' \
+	"$CHAIN_HOME/config/client.toml"
+
+for key in validator user; do
+	pickled keys add "$key" --keyring-backend test >/dev/null 2>&1
+	pickled genesis add-genesis-account "$key" "1000000000$DENOM" --keyring-backend test
+done
+pickled genesis gentx validator "100000000$DENOM" --chain-id "$CHAIN_ID" --keyring-backend test >/dev/null 2>&1
+pickled genesis collect-gentxs >/dev/null 2>&1
+
+genesis="$CHAIN_HOME/config/genesis.json"
+jq --arg name "$WORK_TYPE" '.app_state.workqueue.work_types = [{"name": $name, "enabled": true}]' \
+	"$genesis" > "$genesis.tmp"
+mv "$genesis.tmp" "$genesis"
+pickled genesis validate-genesis
+
+echo "Keys: validator $(pickled keys show validator -a --keyring-backend test)," \
+	"user $(pickled keys show user -a --keyring-backend test)"
+echo "Work type: $WORK_TYPE"
+exec "$BINARY" start --home "$CHAIN_HOME"
@@ -0,0 +1,131 @@
+package pickle
+
+import (
+	"context"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	bondingcurvetypes "github.com/maco144/pickle/x/bondingcurve/types"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+const (
+	// UpgradeNameV2 is the upgrade that adds x/upgrade and x/bondingcurve and
+	// migrates the workqueue store from consensus version 1
+	UpgradeNameV2 = "v2"
+
+	// UpgradeNameV3 is the upgrade that moves the workqueue store to
+	// collections, consensus version 3
+	UpgradeNameV3 = "v3"
+
+	// UpgradeNameV4 is the upgrade that adds x/gov, whose module account is
+	// the authority of every module
+	UpgradeNameV4 = "v4"
+)
+
+// haltUpgrades are the upgrades of chains that cannot schedule them. Before
+// v4 the chain has no x/gov to sign the MsgSoftwareUpgrade scheduling a plan,
+// and before v2 no x/upgrade to store it, so these upgrades are applied at a
+// coordinated halt instead:
+//
+//  1. Every operator stops the old binary at height H-1, with
+//     --halt-height H-1.
+//  2. Every operator writes data/upgrade-info.json in the node home naming the
+//     upgrade and its height, e.g. {"name":"v4","height":H}.
+//  3. Every operator starts the new binary, which adds the upgrade's stores
+//     when loading and runs its handler in the PreBlocker of block H.
+//
+// Operators who agree to skip the upgrade instead start with
+// --unsafe-skip-upgrades H. Upgrades from v4 on are scheduled by governance
+// proposals and applied by x/upgrade.
+var haltUpgrades = map[string]bool{
+	UpgradeNameV2: true,
+	UpgradeNameV3: true,
+	UpgradeNameV4: true,
+}
+
+// registerUpgradeHandlers registers upgrade handlers for the app and sets the
+// store loader adding the stores of an upgrade about to be applied
+func (app *App) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameV2,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// Chains from before v2 ran without x/upgrade and stored no
+			// version map: every module they had is at its current version
+			// except workqueue, and bondingcurve is initialized from its
+			// default genesis
+			if len(fromVM) == 0 {
+				fromVM = app.mm.GetVersionMap()
+				fromVM[workqueuetypes.ModuleName] = 1
+				delete(fromVM, bondingcurvetypes.ModuleName)
+			}
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameV3,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameV4,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+	if haltUpgrades[upgradeInfo.Name] {
+		app.haltUpgrade = upgradeInfo
+	}
+
+	switch upgradeInfo.Name {
+	case UpgradeNameV2:
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{upgradetypes.StoreKey, bondingcurvetypes.StoreKey},
+		}))
+	case UpgradeNameV4:
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{govtypes.StoreKey},
+		}))
+	}
+}
+
+// applyHaltUpgrade applies the upgrade the chain was halted for when the
+// block is at its height, unless a plan scheduled in state is due instead or
+// the upgrade was already applied. It reports whether the upgrade was applied.
+func (app *App) applyHaltUpgrade(ctx sdk.Context) (bool, error) {
+	plan := app.haltUpgrade
+	if plan.Name == "" || ctx.BlockHeight() != plan.Height {
+		return false, nil
+	}
+
+	if _, err := app.UpgradeKeeper.GetUpgradePlan(ctx); err == nil {
+		return false, nil
+	} else if !errors.Is(err, upgradetypes.ErrNoUpgradePlanFound) {
+		return false, err
+	}
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, plan.Name)
+	if err != nil || doneHeight != 0 {
+		return false, err
+	}
+
+	app.Logger().Info("applying halt upgrade", "name", plan.Name, "height", plan.Height)
+	if err := app.UpgradeKeeper.ApplyUpgrade(ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter()), plan); err != nil {
+		return false, err
+	}
+	return true, nil
+}
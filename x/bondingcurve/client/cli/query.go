@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                types.ModuleName,
+		Short:              fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing: true,
+		RunE:               client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryCurrentPrice(),
+		CmdQueryPriceHistory(),
+	)
+
+	return cmd
+}
+
+// CmdQueryParams creates a command to query the curve configuration
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the bonding curve parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCurrentPrice creates a command to query the current price
+func CmdQueryCurrentPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-price",
+		Short: "Query the current price per work unit and prize pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentPrice(cmd.Context(), &types.QueryCurrentPriceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceHistory creates a command to query the price history
+func CmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history",
+		Short: "Query the price history of the bonding curve",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPriceHistoryRequest{
+				Pagination: workqueuetypes.NewPageRequest(pageReq),
+			}
+
+			res, err := queryClient.PriceHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prices")
+	return cmd
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if genState == nil {
+		return
+	}
+
+	if genState.Params != nil {
+		k.SetParams(ctx, genState.Params)
+	}
+
+	if genState.State != nil {
+		k.SetState(ctx, genState.State)
+	}
+
+	for _, price := range genState.HistoricalPrices {
+		k.SetPrice(ctx, price)
+	}
+}
+
+// ExportGenesis exports the module's state to a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	state, err := k.GetState(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := &types.GenesisState{
+		Params:           k.GetParams(ctx),
+		State:            state,
+		HistoricalPrices: []*types.Price{},
+	}
+
+	k.IteratePrices(ctx, func(price *types.Price) bool {
+		genState.HistoricalPrices = append(genState.HistoricalPrices, price)
+		return false
+	})
+
+	return genState
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+var _ workqueuetypes.WorkqueueHooks = Hooks{}
+
+// Hooks moves the bonding curve in response to workqueue events
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the workqueue hooks implemented by the bonding curve keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterWorkSubmitted implements the workqueue hooks. Submissions do not move
+// the curve.
+func (h Hooks) AfterWorkSubmitted(_ context.Context, _ *workqueuetypes.WorkUnit) error {
+	return nil
+}
+
+// AfterWorkValidated moves the curve forward by one validated unit
+func (h Hooks) AfterWorkValidated(ctx context.Context, _ *workqueuetypes.WorkUnit) error {
+	return h.k.RecordValidation(sdk.UnwrapSDKContext(ctx))
+}
+
+// AfterWorkRejected implements the workqueue hooks. Rejected work does not
+// move the curve.
+func (h Hooks) AfterWorkRejected(_ context.Context, _ *workqueuetypes.WorkUnit) error {
+	return nil
+}
+
+// AfterWorkExpired implements the workqueue hooks. Expired work does not move
+// the curve.
+func (h Hooks) AfterWorkExpired(_ context.Context, _ *workqueuetypes.WorkUnit) error {
+	return nil
+}
+
+// AfterWorkOverturned moves the curve forward by one unit when a challenge
+// validates rejected work, and back by one when it rejects validated work, so
+// the curve keeps counting the work currently validated
+func (h Hooks) AfterWorkOverturned(ctx context.Context, work *workqueuetypes.WorkUnit, originalStatus string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	switch {
+	case work.Status == workqueuetypes.WorkStatusValidated:
+		return h.k.RecordValidation(sdkCtx)
+	case originalStatus == workqueuetypes.WorkStatusValidated:
+		return h.k.RevertValidation(sdkCtx)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey *storetypes.KVStoreKey
+
+		// authority is the address allowed to reshape the curve
+		authority string
+	}
+)
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey *storetypes.KVStoreKey,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the curve configuration
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the curve configuration, falling back to the default
+// curve when none is set
+func (k Keeper) GetParams(ctx sdk.Context) *types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return &params
+}
+
+// SetParams stores the curve configuration
+func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(params)
+	store.Set(types.KeyParams, bz)
+}
+
+// GetState returns the current position on the curve. Before any unit has
+// been validated this is the curve's starting price with an empty pool.
+func (k Keeper) GetState(ctx sdk.Context) (*types.BondingCurveState, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyState)
+	if bz == nil {
+		price, err := k.GetParams(ctx).Price(0)
+		if err != nil {
+			return nil, err
+		}
+		return &types.BondingCurveState{
+			CurrentPrice: price.String(),
+			PrizePool:    math.LegacyZeroDec().String(),
+		}, nil
+	}
+
+	var state types.BondingCurveState
+	k.cdc.MustUnmarshal(bz, &state)
+	return &state, nil
+}
+
+// SetState stores the current position on the curve
+func (k Keeper) SetState(ctx sdk.Context, state *types.BondingCurveState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(state)
+	store.Set(types.KeyState, bz)
+}
+
+// SetPrice stores a point in the price history
+func (k Keeper) SetPrice(ctx sdk.Context, price *types.Price) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(price)
+	store.Set(types.PriceKey(price.Units), bz)
+}
+
+// deletePrice removes the point of the price history at a unit count
+func (k Keeper) deletePrice(ctx sdk.Context, units uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PriceKey(units))
+}
+
+// IteratePrices iterates over the price history in unit order
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(price *types.Price) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPrice)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		if cb(&price) {
+			break
+		}
+	}
+}
+
+// RecordValidation moves the curve forward by one validated unit, updating
+// the current price and prize pool and appending to the price history
+func (k Keeper) RecordValidation(ctx sdk.Context) error {
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	units := state.TotalUnitsValidated + 1
+	price, err := k.GetParams(ctx).Price(units)
+	if err != nil {
+		return err
+	}
+	prizePool := price.MulInt(math.NewIntFromUint64(units))
+
+	state.TotalUnitsValidated = units
+	state.CurrentPrice = price.String()
+	state.PrizePool = prizePool.String()
+	k.SetState(ctx, state)
+
+	k.SetPrice(ctx, &types.Price{
+		Units:       units,
+		Price:       price.String(),
+		BlockTime:   ctx.BlockTime().Unix(),
+		BlockHeight: ctx.BlockHeight(),
+	})
+
+	emitPriceUpdated(ctx, state)
+
+	return nil
+}
+
+// RevertValidation moves the curve back by one unit when a validation is
+// overturned, updating the current price and prize pool and dropping the
+// price recorded for the unit. A curve with no validated units is left
+// unchanged, as happens for work validated before the curve was added.
+func (k Keeper) RevertValidation(ctx sdk.Context) error {
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.TotalUnitsValidated == 0 {
+		return nil
+	}
+
+	units := state.TotalUnitsValidated - 1
+	price, err := k.GetParams(ctx).Price(units)
+	if err != nil {
+		return err
+	}
+	prizePool := price.MulInt(math.NewIntFromUint64(units))
+
+	k.deletePrice(ctx, state.TotalUnitsValidated)
+	state.TotalUnitsValidated = units
+	state.CurrentPrice = price.String()
+	state.PrizePool = prizePool.String()
+	k.SetState(ctx, state)
+
+	emitPriceUpdated(ctx, state)
+
+	return nil
+}
+
+// Reprice moves the current price and prize pool onto the curve at the
+// current unit count, as when the curve is reshaped. Prices already in the
+// history are kept as they were recorded.
+func (k Keeper) Reprice(ctx sdk.Context) error {
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	price, err := k.GetParams(ctx).Price(state.TotalUnitsValidated)
+	if err != nil {
+		return err
+	}
+	prizePool := price.MulInt(math.NewIntFromUint64(state.TotalUnitsValidated))
+
+	state.CurrentPrice = price.String()
+	state.PrizePool = prizePool.String()
+	k.SetState(ctx, state)
+
+	emitPriceUpdated(ctx, state)
+
+	return nil
+}
+
+// emitPriceUpdated emits the curve's new position
+func emitPriceUpdated(ctx sdk.Context, state *types.BondingCurveState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePriceUpdated,
+			sdk.NewAttribute(types.AttributeKeyUnits, fmt.Sprintf("%d", state.TotalUnitsValidated)),
+			sdk.NewAttribute(types.AttributeKeyPrice, state.CurrentPrice),
+			sdk.NewAttribute(types.AttributeKeyPrizePool, state.PrizePool),
+		),
+	)
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/bondingcurve/keeper"
+	"github.com/maco144/pickle/x/bondingcurve/types"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+func newTestKeeper() (keeper.Keeper, sdk.Context) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return keeper.NewKeeper(cdc, key, "authority"), ctx
+}
+
+func TestValidatedWorkMovesTheCurve(t *testing.T) {
+	k, ctx := newTestKeeper()
+	hooks := k.Hooks()
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		t.Fatalf("failed to get the curve state: %v", err)
+	}
+	if state.TotalUnitsValidated != 0 || state.CurrentPrice != "1.000000000000000000" || state.PrizePool != "0.000000000000000000" {
+		t.Fatalf("curve starts at %v, want the starting price and an empty pool", state)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := hooks.AfterWorkValidated(ctx, &workqueuetypes.WorkUnit{}); err != nil {
+			t.Fatalf("validation hook failed: %v", err)
+		}
+	}
+	state, _ = k.GetState(ctx)
+	if state.TotalUnitsValidated != 3 || state.CurrentPrice != "1.003000000000000000" || state.PrizePool != "3.009000000000000000" {
+		t.Fatalf("curve is at %v after three units, want 3 units at 1.003 with a pool of 3.009", state)
+	}
+
+	// Every unit is recorded in the price history
+	var units []uint64
+	k.IteratePrices(ctx, func(price *types.Price) bool {
+		if price.BlockHeight != ctx.BlockHeight() {
+			t.Fatalf("price of unit %d recorded at %d, want %d", price.Units, price.BlockHeight, ctx.BlockHeight())
+		}
+		units = append(units, price.Units)
+		return false
+	})
+	if len(units) != 3 || units[0] != 1 || units[2] != 3 {
+		t.Fatalf("price history covers units %v, want 1 to 3", units)
+	}
+
+	// A malformed curve stops the hook rather than mispricing
+	k.SetParams(ctx, &types.Params{Base: "1", Multiplier: "1", Shape: "logarithmic"})
+	if err := hooks.AfterWorkValidated(ctx, &workqueuetypes.WorkUnit{}); !errors.Is(err, types.ErrInvalidParams) {
+		t.Fatalf("validation hook under a malformed curve returned %v, want %v", err, types.ErrInvalidParams)
+	}
+}
+
+func TestOverturnedWorkMovesTheCurve(t *testing.T) {
+	k, ctx := newTestKeeper()
+	hooks := k.Hooks()
+	validated := &workqueuetypes.WorkUnit{Status: workqueuetypes.WorkStatusValidated}
+	rejected := &workqueuetypes.WorkUnit{Status: workqueuetypes.WorkStatusRejected}
+
+	// Rejecting validated work on a curve with no units leaves it unchanged
+	if err := hooks.AfterWorkOverturned(ctx, rejected, workqueuetypes.WorkStatusValidated); err != nil {
+		t.Fatalf("overturn hook failed: %v", err)
+	}
+	if state, _ := k.GetState(ctx); state.TotalUnitsValidated != 0 || state.CurrentPrice != "1.000000000000000000" {
+		t.Fatalf("empty curve moved to %v on an overturn, want it unchanged", state)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := hooks.AfterWorkValidated(ctx, validated); err != nil {
+			t.Fatalf("validation hook failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		work           *workqueuetypes.WorkUnit
+		originalStatus string
+		units          uint64
+		price, pool    string
+	}{
+		{"validated work rejected", rejected, workqueuetypes.WorkStatusValidated, 1, "1.001000000000000000", "1.001000000000000000"},
+		{"rejected work validated", validated, workqueuetypes.WorkStatusRejected, 2, "1.002000000000000000", "2.004000000000000000"},
+	}
+	for _, tc := range tests {
+		if err := hooks.AfterWorkOverturned(ctx, tc.work, tc.originalStatus); err != nil {
+			t.Fatalf("overturn hook failed on %s: %v", tc.name, err)
+		}
+		state, _ := k.GetState(ctx)
+		if state.TotalUnitsValidated != tc.units || state.CurrentPrice != tc.price || state.PrizePool != tc.pool {
+			t.Fatalf("curve is at %v after %s, want %d units at %s with a pool of %s", state, tc.name, tc.units, tc.price, tc.pool)
+		}
+	}
+
+	// Reverting drops the price of the unit from the history
+	if err := k.RevertValidation(ctx); err != nil {
+		t.Fatalf("failed to revert a validation: %v", err)
+	}
+	var units []uint64
+	k.IteratePrices(ctx, func(price *types.Price) bool {
+		units = append(units, price.Units)
+		return false
+	})
+	if len(units) != 1 || units[0] != 1 {
+		t.Fatalf("price history covers units %v after reverting to one unit, want [1]", units)
+	}
+}
+
+func TestUpdateParamsRequiresAuthority(t *testing.T) {
+	k, ctx := newTestKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	if err := k.Hooks().AfterWorkValidated(ctx, &workqueuetypes.WorkUnit{}); err != nil {
+		t.Fatalf("validation hook failed: %v", err)
+	}
+
+	steeper := types.DefaultParams()
+	steeper.Multiplier = "0.01"
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "mallory", Params: steeper}); !errors.Is(err, types.ErrInvalidAuthority) {
+		t.Fatalf("updating params from another address returned %v, want %v", err, types.ErrInvalidAuthority)
+	}
+	invalid := types.DefaultParams()
+	invalid.Shape = "logarithmic"
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid}); !errors.Is(err, types.ErrInvalidParams) {
+		t.Fatalf("updating to invalid params returned %v, want %v", err, types.ErrInvalidParams)
+	}
+	if params := k.GetParams(ctx); params.Multiplier != types.DefaultParams().Multiplier || params.Shape != types.ShapeLinear {
+		t.Fatalf("curve is %v after refused updates, want the default curve", params)
+	}
+
+	// The current position is repriced on the new curve and the history kept
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: steeper}); err != nil {
+		t.Fatalf("failed to update params: %v", err)
+	}
+	if state, _ := k.GetState(ctx); state.TotalUnitsValidated != 1 || state.CurrentPrice != "1.010000000000000000" || state.PrizePool != "1.010000000000000000" {
+		t.Fatalf("curve is at %v after reshaping, want 1 unit at 1.01 with a pool of 1.01", state)
+	}
+	var prices []string
+	k.IteratePrices(ctx, func(price *types.Price) bool {
+		prices = append(prices, price.Price)
+		return false
+	})
+	if len(prices) != 1 || prices[0] != "1.001000000000000000" {
+		t.Fatalf("price history is %v after reshaping, want [1.001]", prices)
+	}
+}
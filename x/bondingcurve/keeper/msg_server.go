@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+)
+
+type msgServer struct {
+	Keeper
+	types.UnimplementedMsgServer
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams implements the MsgServer.UpdateParams method
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", ms.Keeper.GetAuthority(), msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The curve's current position is priced on the new shape
+	ms.Keeper.SetParams(ctx, msg.Params)
+	if err := ms.Keeper.Reprice(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
+)
+
+type queryServer struct {
+	Keeper
+	types.UnimplementedQueryServer
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+// Params implements the Query.Params method
+func (qs queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: qs.Keeper.GetParams(ctx),
+	}, nil
+}
+
+// CurrentPrice implements the Query.CurrentPrice method
+func (qs queryServer) CurrentPrice(goCtx context.Context, req *types.QueryCurrentPriceRequest) (*types.QueryCurrentPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	state, err := qs.Keeper.GetState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCurrentPriceResponse{
+		State: state,
+	}, nil
+}
+
+// PriceHistory implements the Query.PriceHistory method
+func (qs queryServer) PriceHistory(goCtx context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(qs.storeKey), types.KeyPrefixPrice)
+
+	var prices []*types.Price
+	pageRes, err := query.Paginate(store, workqueuetypes.SDKPageRequest(req.Pagination), func(_, value []byte) error {
+		var price types.Price
+		if err := qs.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+		prices = append(prices, &price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Prices:     prices,
+		Pagination: workqueuetypes.NewPageResponse(pageRes),
+	}, nil
+}
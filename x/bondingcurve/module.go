@@ -0,0 +1,116 @@
+package bondingcurve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/abci/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/maco144/pickle/x/bondingcurve/client/cli"
+	"github.com/maco144/pickle/x/bondingcurve/keeper"
+	bondingcurvetypes "github.com/maco144/pickle/x/bondingcurve/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the bondingcurve module.
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
+
+// Name returns the bondingcurve module's name.
+func (AppModuleBasic) Name() string {
+	return bondingcurvetypes.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the module's types on the LegacyAmino codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers the module's interface types
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	bondingcurvetypes.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the bondingcurve
+// module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(bondingcurvetypes.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the bondingcurve module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState bondingcurvetypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", bondingcurvetypes.ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+// GetQueryCmd returns the root query command for the bondingcurve module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd(bondingcurvetypes.StoreKey)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bondingcurve module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := bondingcurvetypes.RegisterQueryHandlerClient(context.Background(), mux, bondingcurvetypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// AppModule implements an application module for the bondingcurve module.
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates and returns a new bondingcurve module.
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         keeper,
+	}
+}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (AppModule) IsAppModule() {}
+
+// IsOnePerModuleType implements the appmodule.IsOnePerModuleType interface.
+func (AppModule) IsOnePerModuleType() {}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	bondingcurvetypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	bondingcurvetypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+}
+
+// InitGenesis performs genesis initialization for the bondingcurve module. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []types.ValidatorUpdate {
+	var genState bondingcurvetypes.GenesisState
+	cdc.MustUnmarshalJSON(data, &genState)
+	am.keeper.InitGenesis(ctx, &genState)
+	return []types.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// bondingcurve module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := am.keeper.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(genState)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
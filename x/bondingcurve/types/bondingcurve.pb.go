@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: bondingcurve/v1/bondingcurve.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the shape of the bonding curve
+type Params struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base is the price of a work unit before any work has been validated
+	Base string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Multiplier scales how fast the price grows with validated units
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Shape is the curve shape: linear, exponential or sigmoid
+	Shape string `protobuf:"bytes,3,opt,name=shape,proto3" json:"shape,omitempty"`
+	// Midpoint is the number of validated units at which a sigmoid curve
+	// reaches half of its range
+	Midpoint      uint64 `protobuf:"varint,4,opt,name=midpoint,proto3" json:"midpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_bondingcurve_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *Params) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+func (x *Params) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *Params) GetMidpoint() uint64 {
+	if x != nil {
+		return x.Midpoint
+	}
+	return 0
+}
+
+// BondingCurveState is the current position on the bonding curve
+type BondingCurveState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TotalUnitsValidated is the cumulative number of validated work units
+	TotalUnitsValidated uint64 `protobuf:"varint,1,opt,name=total_units_validated,json=totalUnitsValidated,proto3" json:"total_units_validated,omitempty"`
+	// CurrentPrice is the price per work unit at the current unit count
+	CurrentPrice string `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// PrizePool is the total units multiplied by the current price
+	PrizePool     string `protobuf:"bytes,3,opt,name=prize_pool,json=prizePool,proto3" json:"prize_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BondingCurveState) Reset() {
+	*x = BondingCurveState{}
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondingCurveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondingCurveState) ProtoMessage() {}
+
+func (x *BondingCurveState) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondingCurveState.ProtoReflect.Descriptor instead.
+func (*BondingCurveState) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_bondingcurve_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BondingCurveState) GetTotalUnitsValidated() uint64 {
+	if x != nil {
+		return x.TotalUnitsValidated
+	}
+	return 0
+}
+
+func (x *BondingCurveState) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *BondingCurveState) GetPrizePool() string {
+	if x != nil {
+		return x.PrizePool
+	}
+	return ""
+}
+
+// Price is a point in the price history of the bonding curve
+type Price struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Units is the unit count at which the price applied
+	Units uint64 `protobuf:"varint,1,opt,name=units,proto3" json:"units,omitempty"`
+	// Price is the price per work unit at that unit count
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// BlockTime is the unix time of the block that moved the curve
+	BlockTime int64 `protobuf:"varint,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// BlockHeight is the height of the block that moved the curve
+	BlockHeight   int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_bondingcurve_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Price) GetUnits() uint64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Price) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Price) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *Price) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// GenesisState defines the bonding curve module's genesis state
+type GenesisState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Params is the curve configuration
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// State is the current position on the curve
+	State *BondingCurveState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// HistoricalPrices is the price history, ordered by unit count
+	HistoricalPrices []*Price `protobuf:"bytes,3,rep,name=historical_prices,json=historicalPrices,proto3" json:"historical_prices,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_bondingcurve_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_bondingcurve_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetState() *BondingCurveState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHistoricalPrices() []*Price {
+	if x != nil {
+		return x.HistoricalPrices
+	}
+	return nil
+}
+
+var File_bondingcurve_v1_bondingcurve_proto protoreflect.FileDescriptor
+
+const file_bondingcurve_v1_bondingcurve_proto_rawDesc = "" +
+	"\n" +
+	"\"bondingcurve/v1/bondingcurve.proto\x12\x16pickle.bondingcurve.v1\"n\n" +
+	"\x06Params\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\tR\n" +
+	"multiplier\x12\x14\n" +
+	"\x05shape\x18\x03 \x01(\tR\x05shape\x12\x1a\n" +
+	"\bmidpoint\x18\x04 \x01(\x04R\bmidpoint\"\x8b\x01\n" +
+	"\x11BondingCurveState\x122\n" +
+	"\x15total_units_validated\x18\x01 \x01(\x04R\x13totalUnitsValidated\x12#\n" +
+	"\rcurrent_price\x18\x02 \x01(\tR\fcurrentPrice\x12\x1d\n" +
+	"\n" +
+	"prize_pool\x18\x03 \x01(\tR\tprizePool\"u\n" +
+	"\x05Price\x12\x14\n" +
+	"\x05units\x18\x01 \x01(\x04R\x05units\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\x12\x1d\n" +
+	"\n" +
+	"block_time\x18\x03 \x01(\x03R\tblockTime\x12!\n" +
+	"\fblock_height\x18\x04 \x01(\x03R\vblockHeight\"\xd3\x01\n" +
+	"\fGenesisState\x126\n" +
+	"\x06params\x18\x01 \x01(\v2\x1e.pickle.bondingcurve.v1.ParamsR\x06params\x12?\n" +
+	"\x05state\x18\x02 \x01(\v2).pickle.bondingcurve.v1.BondingCurveStateR\x05state\x12J\n" +
+	"\x11historical_prices\x18\x03 \x03(\v2\x1d.pickle.bondingcurve.v1.PriceR\x10historicalPricesB0Z.github.com/maco144/pickle/x/bondingcurve/typesb\x06proto3"
+
+var (
+	file_bondingcurve_v1_bondingcurve_proto_rawDescOnce sync.Once
+	file_bondingcurve_v1_bondingcurve_proto_rawDescData []byte
+)
+
+func file_bondingcurve_v1_bondingcurve_proto_rawDescGZIP() []byte {
+	file_bondingcurve_v1_bondingcurve_proto_rawDescOnce.Do(func() {
+		file_bondingcurve_v1_bondingcurve_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_bondingcurve_proto_rawDesc), len(file_bondingcurve_v1_bondingcurve_proto_rawDesc)))
+	})
+	return file_bondingcurve_v1_bondingcurve_proto_rawDescData
+}
+
+var file_bondingcurve_v1_bondingcurve_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bondingcurve_v1_bondingcurve_proto_goTypes = []any{
+	(*Params)(nil),            // 0: pickle.bondingcurve.v1.Params
+	(*BondingCurveState)(nil), // 1: pickle.bondingcurve.v1.BondingCurveState
+	(*Price)(nil),             // 2: pickle.bondingcurve.v1.Price
+	(*GenesisState)(nil),      // 3: pickle.bondingcurve.v1.GenesisState
+}
+var file_bondingcurve_v1_bondingcurve_proto_depIdxs = []int32{
+	0, // 0: pickle.bondingcurve.v1.GenesisState.params:type_name -> pickle.bondingcurve.v1.Params
+	1, // 1: pickle.bondingcurve.v1.GenesisState.state:type_name -> pickle.bondingcurve.v1.BondingCurveState
+	2, // 2: pickle.bondingcurve.v1.GenesisState.historical_prices:type_name -> pickle.bondingcurve.v1.Price
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_bondingcurve_v1_bondingcurve_proto_init() }
+func file_bondingcurve_v1_bondingcurve_proto_init() {
+	if File_bondingcurve_v1_bondingcurve_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_bondingcurve_proto_rawDesc), len(file_bondingcurve_v1_bondingcurve_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bondingcurve_v1_bondingcurve_proto_goTypes,
+		DependencyIndexes: file_bondingcurve_v1_bondingcurve_proto_depIdxs,
+		MessageInfos:      file_bondingcurve_v1_bondingcurve_proto_msgTypes,
+	}.Build()
+	File_bondingcurve_v1_bondingcurve_proto = out.File
+	file_bondingcurve_v1_bondingcurve_proto_goTypes = nil
+	file_bondingcurve_v1_bondingcurve_proto_depIdxs = nil
+}
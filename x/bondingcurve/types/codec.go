@@ -0,0 +1,19 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RegisterInterfaces registers the Msgs of the Msg service and their
+// responses
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+
+	registry.RegisterImplementations((*tx.MsgResponse)(nil),
+		&MsgUpdateParamsResponse{},
+	)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/bondingcurve module sentinel errors
+var (
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 2, "invalid bonding curve params")
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 3, "invalid authority")
+)
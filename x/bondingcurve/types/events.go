@@ -0,0 +1,9 @@
+package types
+
+const (
+	EventTypePriceUpdated = "price_updated"
+
+	AttributeKeyUnits     = "units"
+	AttributeKeyPrice     = "price"
+	AttributeKeyPrizePool = "prize_pool"
+)
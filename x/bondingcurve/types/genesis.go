@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state: the default curve with no
+// validated units
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	var lastUnits uint64
+	for i, price := range gs.HistoricalPrices {
+		if i > 0 && price.Units <= lastUnits {
+			return fmt.Errorf("historical prices must be ordered by strictly increasing units, got %d after %d", price.Units, lastUnits)
+		}
+		if _, err := parseCurveDec("historical price", price.Price); err != nil {
+			return err
+		}
+		lastUnits = price.Units
+	}
+
+	if gs.State != nil && len(gs.HistoricalPrices) > 0 && lastUnits > gs.State.TotalUnitsValidated {
+		return fmt.Errorf("price history reaches %d units but only %d were validated", lastUnits, gs.State.TotalUnitsValidated)
+	}
+
+	return nil
+}
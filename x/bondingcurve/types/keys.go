@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "bondingcurve"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// KeyParams stores the curve configuration
+	KeyParams = []byte{0x01}
+
+	// KeyState stores the current position on the curve
+	KeyState = []byte{0x02}
+
+	// KeyPrefixPrice is the prefix for the unit count -> price history
+	KeyPrefixPrice = []byte{0x03}
+)
+
+// PriceKey returns the key for the price recorded at a unit count
+func PriceKey(units uint64) []byte {
+	return append(append([]byte{}, KeyPrefixPrice...), sdk.Uint64ToBigEndian(units)...)
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+const (
+	// ShapeLinear prices a unit at base + multiplier × units
+	ShapeLinear = "linear"
+	// ShapeExponential prices a unit at base × (1 + multiplier)^units
+	ShapeExponential = "exponential"
+	// ShapeSigmoid prices a unit at
+	// base + multiplier × units² / (midpoint² + units²), rising from base to
+	// base + multiplier and reaching half of that range at the midpoint
+	ShapeSigmoid = "sigmoid"
+)
+
+// MaxPrice bounds the price on every curve so that steep curves cannot
+// overflow decimal arithmetic
+var MaxPrice = math.LegacyNewDec(10).Power(24)
+
+// DefaultParams returns the default curve: a linear curve starting at one
+// unit of price that grows by a thousandth per validated unit
+func DefaultParams() *Params {
+	return &Params{
+		Base:       "1.000000000000000000",
+		Multiplier: "0.001000000000000000",
+		Shape:      ShapeLinear,
+		Midpoint:   100_000,
+	}
+}
+
+// Validate checks that the curve is well formed
+func (p *Params) Validate() error {
+	if p == nil {
+		return errorsmod.Wrap(ErrInvalidParams, "params cannot be empty")
+	}
+
+	if _, err := parseCurveDec("base", p.Base); err != nil {
+		return err
+	}
+	if _, err := parseCurveDec("multiplier", p.Multiplier); err != nil {
+		return err
+	}
+
+	switch p.Shape {
+	case ShapeLinear, ShapeExponential:
+	case ShapeSigmoid:
+		if p.Midpoint == 0 {
+			return errorsmod.Wrap(ErrInvalidParams, "sigmoid midpoint must be positive")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown shape %q", p.Shape)
+	}
+
+	return nil
+}
+
+// Price returns the price per work unit once the given number of units has
+// been validated, capped at MaxPrice
+func (p *Params) Price(units uint64) (math.LegacyDec, error) {
+	if err := p.Validate(); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	base := math.LegacyMustNewDecFromStr(p.Base)
+	multiplier := math.LegacyMustNewDecFromStr(p.Multiplier)
+	n := math.LegacyNewDecFromInt(math.NewIntFromUint64(units))
+
+	var price math.LegacyDec
+	switch p.Shape {
+	case ShapeLinear:
+		price = base.Add(multiplier.Mul(n))
+	case ShapeExponential:
+		price = base.Mul(powCapped(math.LegacyOneDec().Add(multiplier), units, MaxPrice))
+	case ShapeSigmoid:
+		midpoint := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.Midpoint))
+		squared := n.Mul(n)
+		price = base.Add(multiplier.Mul(squared).Quo(midpoint.Mul(midpoint).Add(squared)))
+	}
+
+	if price.GT(MaxPrice) {
+		price = MaxPrice
+	}
+	return price, nil
+}
+
+// parseCurveDec parses a non-negative curve coefficient no larger than
+// MaxPrice
+func parseCurveDec(name, value string) (math.LegacyDec, error) {
+	dec, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInvalidParams, "invalid %s %q: %s", name, value, err)
+	}
+	if dec.IsNegative() || dec.GT(MaxPrice) {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInvalidParams, "%s must be between 0 and %s", name, MaxPrice)
+	}
+	return dec, nil
+}
+
+// powCapped raises x ≥ 1 to the power n by repeated squaring, returning limit
+// as soon as the result would exceed it
+func powCapped(x math.LegacyDec, n uint64, limit math.LegacyDec) math.LegacyDec {
+	result := math.LegacyOneDec()
+	for n > 0 {
+		if n&1 == 1 {
+			result = result.Mul(x)
+			if result.GT(limit) {
+				return limit
+			}
+		}
+		n >>= 1
+		if n > 0 {
+			// Any remaining factor is at least x, so the result would exceed
+			// the limit
+			if x.GT(limit) {
+				return limit
+			}
+			x = x.Mul(x)
+		}
+	}
+	return result
+}
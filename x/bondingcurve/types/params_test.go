@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"errors"
+	stdmath "math"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	"github.com/maco144/pickle/x/bondingcurve/types"
+)
+
+func TestPrice(t *testing.T) {
+	linear := &types.Params{Base: "1", Multiplier: "0.001", Shape: types.ShapeLinear}
+	exponential := &types.Params{Base: "2", Multiplier: "1", Shape: types.ShapeExponential}
+	doubling := &types.Params{Base: "1", Multiplier: "1", Shape: types.ShapeExponential}
+	flat := &types.Params{Base: "3", Multiplier: "0", Shape: types.ShapeExponential}
+	sigmoid := &types.Params{Base: "1", Multiplier: "10", Shape: types.ShapeSigmoid, Midpoint: 100}
+	steep := &types.Params{Base: types.MaxPrice.String(), Multiplier: "1", Shape: types.ShapeLinear}
+
+	tests := []struct {
+		name   string
+		params *types.Params
+		units  uint64
+		want   string
+	}{
+		{"default curve at start", types.DefaultParams(), 0, "1"},
+		{"linear at start", linear, 0, "1"},
+		{"linear", linear, 1_000, "2"},
+		{"linear past the cap", steep, 1, types.MaxPrice.String()},
+		{"exponential at start", exponential, 0, "2"},
+		{"exponential", exponential, 3, "16"},
+		{"exponential without growth", flat, stdmath.MaxUint64, "3"},
+		{"exponential below the cap", doubling, 79, "604462909807314587353088"},
+		{"exponential past the cap", doubling, 80, types.MaxPrice.String()},
+		{"exponential at the largest count", doubling, stdmath.MaxUint64, types.MaxPrice.String()},
+		{"sigmoid at start", sigmoid, 0, "1"},
+		{"sigmoid at the midpoint", sigmoid, 100, "6"},
+		{"sigmoid past the midpoint", sigmoid, 300, "10"},
+		{"sigmoid at the largest count", sigmoid, stdmath.MaxUint64, "11"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := tc.params.Price(tc.units)
+			if err != nil {
+				t.Fatalf("failed to price %d units: %v", tc.units, err)
+			}
+			if want := math.LegacyMustNewDecFromStr(tc.want); !price.Equal(want) {
+				t.Fatalf("%d units priced at %s, want %s", tc.units, price, want)
+			}
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"exponential", &types.Params{Base: "1", Multiplier: "0.5", Shape: types.ShapeExponential}, true},
+		{"sigmoid", &types.Params{Base: "0", Multiplier: "1", Shape: types.ShapeSigmoid, Midpoint: 1}, true},
+		{"empty", nil, false},
+		{"malformed base", &types.Params{Base: "one", Multiplier: "1", Shape: types.ShapeLinear}, false},
+		{"negative multiplier", &types.Params{Base: "1", Multiplier: "-1", Shape: types.ShapeLinear}, false},
+		{"base past the cap", &types.Params{Base: types.MaxPrice.Add(math.LegacyOneDec()).String(), Multiplier: "1", Shape: types.ShapeLinear}, false},
+		{"no shape", &types.Params{Base: "1", Multiplier: "1"}, false},
+		{"unknown shape", &types.Params{Base: "1", Multiplier: "1", Shape: "logarithmic"}, false},
+		{"sigmoid without midpoint", &types.Params{Base: "1", Multiplier: "1", Shape: types.ShapeSigmoid}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if (err == nil) != tc.valid {
+				t.Fatalf("validation returned %v, want valid %t", err, tc.valid)
+			}
+			if err != nil && !errors.Is(err, types.ErrInvalidParams) {
+				t.Fatalf("validation returned %v, want %v", err, types.ErrInvalidParams)
+			}
+			if _, err := tc.params.Price(1); (err == nil) != tc.valid {
+				t.Fatalf("pricing returned %v, want valid %t", err, tc.valid)
+			}
+		})
+	}
+}
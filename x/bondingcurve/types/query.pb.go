@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: bondingcurve/v1/query.proto
+
+package types
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is the request for querying the curve configuration
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is the response for querying the curve configuration
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryCurrentPriceRequest is the request for querying the current price
+type QueryCurrentPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCurrentPriceRequest) Reset() {
+	*x = QueryCurrentPriceRequest{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCurrentPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCurrentPriceRequest) ProtoMessage() {}
+
+func (x *QueryCurrentPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCurrentPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryCurrentPriceRequest) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+// QueryCurrentPriceResponse is the response for querying the current price
+type QueryCurrentPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *BondingCurveState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCurrentPriceResponse) Reset() {
+	*x = QueryCurrentPriceResponse{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCurrentPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCurrentPriceResponse) ProtoMessage() {}
+
+func (x *QueryCurrentPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCurrentPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryCurrentPriceResponse) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryCurrentPriceResponse) GetState() *BondingCurveState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// QueryPriceHistoryRequest is the request for querying the price history
+type QueryPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1beta1.PageRequest   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPriceHistoryRequest) Reset() {
+	*x = QueryPriceHistoryRequest{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceHistoryRequest) ProtoMessage() {}
+
+func (x *QueryPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryPriceHistoryRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPriceHistoryResponse is the response for querying the price history
+type QueryPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prices        []*Price               `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	Pagination    *v1beta1.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPriceHistoryResponse) Reset() {
+	*x = QueryPriceHistoryResponse{}
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceHistoryResponse) ProtoMessage() {}
+
+func (x *QueryPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_query_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryPriceHistoryResponse) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *QueryPriceHistoryResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_bondingcurve_v1_query_proto protoreflect.FileDescriptor
+
+const file_bondingcurve_v1_query_proto_rawDesc = "" +
+	"\n" +
+	"\x1bbondingcurve/v1/query.proto\x12\x16pickle.bondingcurve.v1\x1a*cosmos/base/query/v1beta1/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\"bondingcurve/v1/bondingcurve.proto\"\x14\n" +
+	"\x12QueryParamsRequest\"M\n" +
+	"\x13QueryParamsResponse\x126\n" +
+	"\x06params\x18\x01 \x01(\v2\x1e.pickle.bondingcurve.v1.ParamsR\x06params\"\x1a\n" +
+	"\x18QueryCurrentPriceRequest\"\\\n" +
+	"\x19QueryCurrentPriceResponse\x12?\n" +
+	"\x05state\x18\x01 \x01(\v2).pickle.bondingcurve.v1.BondingCurveStateR\x05state\"b\n" +
+	"\x18QueryPriceHistoryRequest\x12F\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\x9b\x01\n" +
+	"\x19QueryPriceHistoryResponse\x125\n" +
+	"\x06prices\x18\x01 \x03(\v2\x1d.pickle.bondingcurve.v1.PriceR\x06prices\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination2\xce\x03\n" +
+	"\x05Query\x12\x89\x01\n" +
+	"\x06Params\x12*.pickle.bondingcurve.v1.QueryParamsRequest\x1a+.pickle.bondingcurve.v1.QueryParamsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/pickle/bondingcurve/v1/params\x12\x9a\x01\n" +
+	"\fCurrentPrice\x120.pickle.bondingcurve.v1.QueryCurrentPriceRequest\x1a1.pickle.bondingcurve.v1.QueryCurrentPriceResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/pickle/bondingcurve/v1/price\x12\x9b\x01\n" +
+	"\fPriceHistory\x120.pickle.bondingcurve.v1.QueryPriceHistoryRequest\x1a1.pickle.bondingcurve.v1.QueryPriceHistoryResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/pickle/bondingcurve/v1/pricesB0Z.github.com/maco144/pickle/x/bondingcurve/typesb\x06proto3"
+
+var (
+	file_bondingcurve_v1_query_proto_rawDescOnce sync.Once
+	file_bondingcurve_v1_query_proto_rawDescData []byte
+)
+
+func file_bondingcurve_v1_query_proto_rawDescGZIP() []byte {
+	file_bondingcurve_v1_query_proto_rawDescOnce.Do(func() {
+		file_bondingcurve_v1_query_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_query_proto_rawDesc), len(file_bondingcurve_v1_query_proto_rawDesc)))
+	})
+	return file_bondingcurve_v1_query_proto_rawDescData
+}
+
+var file_bondingcurve_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_bondingcurve_v1_query_proto_goTypes = []any{
+	(*QueryParamsRequest)(nil),        // 0: pickle.bondingcurve.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),       // 1: pickle.bondingcurve.v1.QueryParamsResponse
+	(*QueryCurrentPriceRequest)(nil),  // 2: pickle.bondingcurve.v1.QueryCurrentPriceRequest
+	(*QueryCurrentPriceResponse)(nil), // 3: pickle.bondingcurve.v1.QueryCurrentPriceResponse
+	(*QueryPriceHistoryRequest)(nil),  // 4: pickle.bondingcurve.v1.QueryPriceHistoryRequest
+	(*QueryPriceHistoryResponse)(nil), // 5: pickle.bondingcurve.v1.QueryPriceHistoryResponse
+	(*Params)(nil),                    // 6: pickle.bondingcurve.v1.Params
+	(*BondingCurveState)(nil),         // 7: pickle.bondingcurve.v1.BondingCurveState
+	(*v1beta1.PageRequest)(nil),       // 8: cosmos.base.query.v1beta1.PageRequest
+	(*Price)(nil),                     // 9: pickle.bondingcurve.v1.Price
+	(*v1beta1.PageResponse)(nil),      // 10: cosmos.base.query.v1beta1.PageResponse
+}
+var file_bondingcurve_v1_query_proto_depIdxs = []int32{
+	6,  // 0: pickle.bondingcurve.v1.QueryParamsResponse.params:type_name -> pickle.bondingcurve.v1.Params
+	7,  // 1: pickle.bondingcurve.v1.QueryCurrentPriceResponse.state:type_name -> pickle.bondingcurve.v1.BondingCurveState
+	8,  // 2: pickle.bondingcurve.v1.QueryPriceHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	9,  // 3: pickle.bondingcurve.v1.QueryPriceHistoryResponse.prices:type_name -> pickle.bondingcurve.v1.Price
+	10, // 4: pickle.bondingcurve.v1.QueryPriceHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 5: pickle.bondingcurve.v1.Query.Params:input_type -> pickle.bondingcurve.v1.QueryParamsRequest
+	2,  // 6: pickle.bondingcurve.v1.Query.CurrentPrice:input_type -> pickle.bondingcurve.v1.QueryCurrentPriceRequest
+	4,  // 7: pickle.bondingcurve.v1.Query.PriceHistory:input_type -> pickle.bondingcurve.v1.QueryPriceHistoryRequest
+	1,  // 8: pickle.bondingcurve.v1.Query.Params:output_type -> pickle.bondingcurve.v1.QueryParamsResponse
+	3,  // 9: pickle.bondingcurve.v1.Query.CurrentPrice:output_type -> pickle.bondingcurve.v1.QueryCurrentPriceResponse
+	5,  // 10: pickle.bondingcurve.v1.Query.PriceHistory:output_type -> pickle.bondingcurve.v1.QueryPriceHistoryResponse
+	8,  // [8:11] is the sub-list for method output_type
+	5,  // [5:8] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_bondingcurve_v1_query_proto_init() }
+func file_bondingcurve_v1_query_proto_init() {
+	if File_bondingcurve_v1_query_proto != nil {
+		return
+	}
+	file_bondingcurve_v1_bondingcurve_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_query_proto_rawDesc), len(file_bondingcurve_v1_query_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bondingcurve_v1_query_proto_goTypes,
+		DependencyIndexes: file_bondingcurve_v1_query_proto_depIdxs,
+		MessageInfos:      file_bondingcurve_v1_query_proto_msgTypes,
+	}.Build()
+	File_bondingcurve_v1_query_proto = out.File
+	file_bondingcurve_v1_query_proto_goTypes = nil
+	file_bondingcurve_v1_query_proto_depIdxs = nil
+}
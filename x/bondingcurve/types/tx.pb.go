@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: bondingcurve/v1/tx.proto
+
+package types
+
+import (
+	_ "cosmossdk.io/api/cosmos/msg/v1"
+	_ "github.com/cosmos/cosmos-proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams reshapes the bonding curve
+type MsgUpdateParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authority is the address allowed to update the curve, by default the
+	// governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params is the full new curve configuration
+	Params        *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	mi := &file_bondingcurve_v1_tx_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_tx_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse is the response to UpdateParams
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	mi := &file_bondingcurve_v1_tx_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bondingcurve_v1_tx_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_bondingcurve_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+var File_bondingcurve_v1_tx_proto protoreflect.FileDescriptor
+
+const file_bondingcurve_v1_tx_proto_rawDesc = "" +
+	"\n" +
+	"\x18bondingcurve/v1/tx.proto\x12\x16pickle.bondingcurve.v1\x1a\x17cosmos/msg/v1/msg.proto\x1a\x19cosmos_proto/cosmos.proto\x1a\"bondingcurve/v1/bondingcurve.proto\"\x91\x01\n" +
+	"\x0fMsgUpdateParams\x126\n" +
+	"\tauthority\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tauthority\x126\n" +
+	"\x06params\x18\x02 \x01(\v2\x1e.pickle.bondingcurve.v1.ParamsR\x06params:\x0e\x82\xe7\xb0*\tauthority\"\x19\n" +
+	"\x17MsgUpdateParamsResponse2v\n" +
+	"\x03Msg\x12h\n" +
+	"\fUpdateParams\x12'.pickle.bondingcurve.v1.MsgUpdateParams\x1a/.pickle.bondingcurve.v1.MsgUpdateParamsResponse\x1a\x05\x80\xe7\xb0*\x01B0Z.github.com/maco144/pickle/x/bondingcurve/typesb\x06proto3"
+
+var (
+	file_bondingcurve_v1_tx_proto_rawDescOnce sync.Once
+	file_bondingcurve_v1_tx_proto_rawDescData []byte
+)
+
+func file_bondingcurve_v1_tx_proto_rawDescGZIP() []byte {
+	file_bondingcurve_v1_tx_proto_rawDescOnce.Do(func() {
+		file_bondingcurve_v1_tx_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_tx_proto_rawDesc), len(file_bondingcurve_v1_tx_proto_rawDesc)))
+	})
+	return file_bondingcurve_v1_tx_proto_rawDescData
+}
+
+var file_bondingcurve_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bondingcurve_v1_tx_proto_goTypes = []any{
+	(*MsgUpdateParams)(nil),         // 0: pickle.bondingcurve.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil), // 1: pickle.bondingcurve.v1.MsgUpdateParamsResponse
+	(*Params)(nil),                  // 2: pickle.bondingcurve.v1.Params
+}
+var file_bondingcurve_v1_tx_proto_depIdxs = []int32{
+	2, // 0: pickle.bondingcurve.v1.MsgUpdateParams.params:type_name -> pickle.bondingcurve.v1.Params
+	0, // 1: pickle.bondingcurve.v1.Msg.UpdateParams:input_type -> pickle.bondingcurve.v1.MsgUpdateParams
+	1, // 2: pickle.bondingcurve.v1.Msg.UpdateParams:output_type -> pickle.bondingcurve.v1.MsgUpdateParamsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_bondingcurve_v1_tx_proto_init() }
+func file_bondingcurve_v1_tx_proto_init() {
+	if File_bondingcurve_v1_tx_proto != nil {
+		return
+	}
+	file_bondingcurve_v1_bondingcurve_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bondingcurve_v1_tx_proto_rawDesc), len(file_bondingcurve_v1_tx_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bondingcurve_v1_tx_proto_goTypes,
+		DependencyIndexes: file_bondingcurve_v1_tx_proto_depIdxs,
+		MessageInfos:      file_bondingcurve_v1_tx_proto_msgTypes,
+	}.Build()
+	File_bondingcurve_v1_tx_proto = out.File
+	file_bondingcurve_v1_tx_proto_goTypes = nil
+	file_bondingcurve_v1_tx_proto_depIdxs = nil
+}
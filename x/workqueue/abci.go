@@ -0,0 +1,38 @@
+package workqueue
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+)
+
+// EndBlocker returns work units with expired leases to the pending queue,
+// opens the reveal phase of rounds whose commit window has closed, closes
+// reveal phases past their deadline, ends re-validation rounds past
+// their challenge window, expires work past its deadline, settles the
+// bounties of work whose challenge window has closed, assigns pending work to
+// validators when enabled and pays out matured unbonding stake
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	if err := k.ExpireLeases(ctx); err != nil {
+		return err
+	}
+	if err := k.CloseCommitWindows(ctx); err != nil {
+		return err
+	}
+	if err := k.ExpireReveals(ctx); err != nil {
+		return err
+	}
+	if err := k.ExpireChallenges(ctx); err != nil {
+		return err
+	}
+	if err := k.ExpireWork(ctx); err != nil {
+		return err
+	}
+	if err := k.SettleBounties(ctx); err != nil {
+		return err
+	}
+	if err := k.AssignWork(ctx); err != nil {
+		return err
+	}
+	return k.CompleteUnbonding(ctx)
+}
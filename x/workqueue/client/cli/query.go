@@ -11,6 +11,14 @@ import (
 	"github.com/maco144/pickle/x/workqueue/types"
 )
 
+const (
+	FlagStatus         = "status"
+	FlagWorkType       = "work-type"
+	FlagSubmitter      = "submitter"
+	FlagMinSubmittedAt = "min-height"
+	FlagMaxSubmittedAt = "max-height"
+)
+
 // GetQueryCmd returns the query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,8 +31,19 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryWork(),
 		CmdQueryPendingWork(),
+		CmdQueryListWork(),
+		CmdQueryWorkBySubmitter(),
+		CmdQueryWorkVotes(),
+		CmdQueryValidationCommits(),
+		CmdQueryChallenge(),
+		CmdQueryWorkType(),
+		CmdQueryWorkTypes(),
+		CmdQueryBounty(),
+		CmdQueryValidatorBond(),
 		CmdQueryValidatorStats(),
 		CmdQueryTotalStats(),
+		CmdQueryParams(),
+		CmdCheckInvariants(),
 	)
 
 	return cmd
@@ -69,8 +88,21 @@ func CmdQueryPendingWork() *cobra.Command {
 				return err
 			}
 
+			filter, err := workFilterFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.QueryPendingWorkRequest{}
+			req := &types.QueryPendingWorkRequest{
+				Filter:     filter,
+				Pagination: types.NewPageRequest(pageReq),
+			}
 
 			res, err := queryClient.PendingWork(cmd.Context(), req)
 			if err != nil {
@@ -81,6 +113,297 @@ func CmdQueryPendingWork() *cobra.Command {
 		},
 	}
 
+	addWorkFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending work")
+	return cmd
+}
+
+// CmdQueryListWork creates a command to list work units matching a filter
+func CmdQueryListWork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-work",
+		Short: "List work units in any status, optionally filtered",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			filter, err := workFilterFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			filter.Status, err = cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryListWorkRequest{
+				Filter:     filter,
+				Pagination: types.NewPageRequest(pageReq),
+			}
+
+			res, err := queryClient.ListWork(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list work in this status (pending, validating, validated, rejected, challenged, expired)")
+	addWorkFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "work")
+	return cmd
+}
+
+// CmdQueryWorkBySubmitter creates a command to query work submitted by an address
+func CmdQueryWorkBySubmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "work-by-submitter [submitter-address]",
+		Short: "Query work units submitted by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWorkBySubmitterRequest{
+				Submitter:  args[0],
+				Pagination: types.NewPageRequest(pageReq),
+			}
+
+			res, err := queryClient.WorkBySubmitter(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "work-by-submitter")
+	return cmd
+}
+
+// CmdQueryWorkVotes creates a command to query the votes on a work unit
+func CmdQueryWorkVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "work-votes [work-id]",
+		Short: "Query the votes cast on a work unit and their tally",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWorkVotesRequest{WorkId: args[0]}
+
+			res, err := queryClient.WorkVotes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryValidationCommits creates a command to query the unrevealed
+// commitments on a work unit
+func CmdQueryValidationCommits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validation-commits [work-id]",
+		Short: "Query the unrevealed validation commitments on a work unit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryValidationCommitsRequest{WorkId: args[0]}
+
+			res, err := queryClient.ValidationCommits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryChallenge creates a command to query the challenge on a work unit
+func CmdQueryChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge [work-id]",
+		Short: "Query the challenge raised against a work unit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryChallengeRequest{WorkId: args[0]}
+
+			res, err := queryClient.Challenge(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryWorkType creates a command to query a work type definition
+func CmdQueryWorkType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "work-type [name]",
+		Short: "Query a registered work type definition",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWorkTypeRequest{Name: args[0]}
+
+			res, err := queryClient.WorkType(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryWorkTypes creates a command to list the registered work types
+func CmdQueryWorkTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "work-types",
+		Short: "List the registered work type definitions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWorkTypesRequest{
+				Pagination: types.NewPageRequest(pageReq),
+			}
+
+			res, err := queryClient.WorkTypes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "work types")
+	return cmd
+}
+
+// CmdQueryBounty creates a command to query the bounty on a work unit
+func CmdQueryBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bounty [work-id]",
+		Short: "Query the bounty attached to a work unit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBountyRequest{WorkId: args[0]}
+
+			res, err := queryClient.Bounty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryValidatorBond creates a command to query a validator's bond
+func CmdQueryValidatorBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bond [validator-address]",
+		Short: "Query a validator's bonded and unbonding stake",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryValidatorBondRequest{ValidatorAddress: args[0]}
+
+			res, err := queryClient.ValidatorBond(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -139,3 +462,110 @@ func CmdQueryTotalStats() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryParams creates a command to query the module parameters
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the module parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCheckInvariants creates a command to run the module invariants against
+// the current state. It fails when any invariant is broken.
+func CmdCheckInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check the module invariants against the current state",
+		Long:  "Check the module invariants against the current state. The queried node must be started with --workqueue.enable-invariants-query.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCheckInvariantsRequest{}
+
+			res, err := queryClient.CheckInvariants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+
+			broken := 0
+			for _, result := range res.Invariants {
+				if result.Broken {
+					broken++
+				}
+			}
+			if broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken", broken, len(res.Invariants))
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// addWorkFilterFlags adds the flags shared by the work listing commands
+func addWorkFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagWorkType, "", "Only include work of this type")
+	cmd.Flags().String(FlagSubmitter, "", "Only include work submitted by this address")
+	cmd.Flags().Int64(FlagMinSubmittedAt, 0, "Only include work submitted at or after this block height")
+	cmd.Flags().Int64(FlagMaxSubmittedAt, 0, "Only include work submitted at or before this block height")
+}
+
+// workFilterFromFlags builds a work filter from the shared filter flags
+func workFilterFromFlags(cmd *cobra.Command) (*types.WorkFilter, error) {
+	workType, err := cmd.Flags().GetString(FlagWorkType)
+	if err != nil {
+		return nil, err
+	}
+
+	submitter, err := cmd.Flags().GetString(FlagSubmitter)
+	if err != nil {
+		return nil, err
+	}
+
+	minHeight, err := cmd.Flags().GetInt64(FlagMinSubmittedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHeight, err := cmd.Flags().GetInt64(FlagMaxSubmittedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WorkFilter{
+		WorkType:       workType,
+		Submitter:      submitter,
+		MinSubmittedAt: minHeight,
+		MaxSubmittedAt: maxHeight,
+	}, nil
+}
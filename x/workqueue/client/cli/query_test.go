@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestListWorkFlags(t *testing.T) {
+	cmd := CmdQueryListWork()
+	err := cmd.ParseFlags([]string{
+		"--status=validated",
+		"--work-type=crypto",
+		"--submitter=pickle1submitter",
+		"--min-height=5",
+		"--max-height=9",
+		"--page-key=next",
+		"--limit=3",
+		"--count-total",
+		"--reverse",
+	})
+	if err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	filter, err := workFilterFromFlags(cmd)
+	if err != nil {
+		t.Fatalf("failed to read the filter flags: %v", err)
+	}
+	want := &types.WorkFilter{WorkType: "crypto", Submitter: "pickle1submitter", MinSubmittedAt: 5, MaxSubmittedAt: 9}
+	if filter.WorkType != want.WorkType || filter.Submitter != want.Submitter ||
+		filter.MinSubmittedAt != want.MinSubmittedAt || filter.MaxSubmittedAt != want.MaxSubmittedAt {
+		t.Fatalf("filter flags read as %v, want %v", filter, want)
+	}
+	if status, _ := cmd.Flags().GetString(FlagStatus); status != types.WorkStatusValidated {
+		t.Fatalf("status flag reads %q, want %q", status, types.WorkStatusValidated)
+	}
+
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		t.Fatalf("failed to read the pagination flags: %v", err)
+	}
+	wantPage := &query.PageRequest{Key: []byte("next"), Limit: 3, CountTotal: true, Reverse: true}
+	if !reflect.DeepEqual(pageReq, wantPage) {
+		t.Fatalf("pagination flags read as %v, want %v", pageReq, wantPage)
+	}
+	if page := types.NewPageRequest(pageReq); string(page.Key) != "next" || page.Limit != 3 || !page.CountTotal || !page.Reverse {
+		t.Fatalf("page request carried as %v", page)
+	}
+}
+
+func TestWorkFilterFlagsDefaultToNoFilter(t *testing.T) {
+	for _, cmd := range []*cobra.Command{CmdQueryListWork(), CmdQueryPendingWork()} {
+		if err := cmd.ParseFlags(nil); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		filter, err := workFilterFromFlags(cmd)
+		if err != nil {
+			t.Fatalf("failed to read the filter flags: %v", err)
+		}
+		if filter.WorkType != "" || filter.Submitter != "" || filter.MinSubmittedAt != 0 || filter.MaxSubmittedAt != 0 {
+			t.Fatalf("%s filters %v without flags, want nothing", cmd.Name(), filter)
+		}
+	}
+
+	// Pending work has no status to filter by
+	if CmdQueryPendingWork().Flags().Lookup(FlagStatus) != nil {
+		t.Fatal("pending-work takes a status flag")
+	}
+}
+
+func TestWorkFilterFlagsRejectMalformedHeights(t *testing.T) {
+	for _, arg := range []string{"--min-height=tall", "--max-height=1.5"} {
+		if err := CmdQueryListWork().ParseFlags([]string{arg}); err == nil {
+			t.Fatalf("parsing %s succeeded", arg)
+		}
+	}
+}
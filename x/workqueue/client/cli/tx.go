@@ -1,17 +1,29 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maco144/pickle/x/workqueue/types"
 )
 
+const (
+	// FlagBounty is the flag for the bounty escrowed with submitted work
+	FlagBounty = "bounty"
+	// FlagPriority is the flag for the scheduling priority of submitted work
+	FlagPriority = "priority"
+	// FlagProof is the flag for the proof accompanying a committed verdict
+	FlagProof = "proof"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,8 +35,14 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdSubmitWork(),
+		CmdClaimWork(),
 		CmdValidateWork(),
+		CmdCommitValidation(),
+		CmdRevealValidation(),
 		CmdRejectWork(),
+		CmdChallengeWork(),
+		CmdBond(),
+		CmdUnbond(),
 	)
 
 	return cmd
@@ -48,6 +66,50 @@ func CmdSubmitWork() *cobra.Command {
 				WorkData:  []byte(args[1]),
 			}
 
+			bountyStr, err := cmd.Flags().GetString(FlagBounty)
+			if err != nil {
+				return err
+			}
+			if bountyStr != "" {
+				bounty, err := sdk.ParseCoinNormalized(bountyStr)
+				if err != nil {
+					return fmt.Errorf("invalid bounty: %w", err)
+				}
+				msg.Bounty = types.NewProtoCoin(bounty)
+			}
+
+			msg.Priority, err = cmd.Flags().GetUint32(FlagPriority)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBounty, "", "Bounty to escrow with the work, paid to the validators who validate it")
+	cmd.Flags().Uint32(FlagPriority, 0, "Scheduling priority of the work, higher is served first")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdClaimWork creates a command to claim work
+func CmdClaimWork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-work [work-id]",
+		Short: "Claim a pending work unit for validation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimWork{
+				Validator: clientCtx.GetFromAddress().String(),
+				WorkId:    args[0],
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -90,6 +152,102 @@ func CmdValidateWork() *cobra.Command {
 	return cmd
 }
 
+// CmdCommitValidation creates a command to commit to a sealed verdict
+func CmdCommitValidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-validation [work-id] [valid] [confidence] [salt-hex]",
+		Short: "Commit to a sealed verdict on a claimed work unit",
+		Long: fmt.Sprintf(`Commit to a sealed verdict on a claimed work unit. The salt is a secret
+hex encoded random value of at least %d bytes that must be kept to reveal the
+verdict with the same arguments once the work enters its reveal phase.`, types.MinCommitSaltLength),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valid, confidence, proof, salt, err := verdictFromArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			validator := clientCtx.GetFromAddress().String()
+			msg := &types.MsgCommitValidation{
+				Validator:  validator,
+				WorkId:     args[0],
+				Commitment: types.ValidationCommitment(args[0], validator, valid, confidence, proof, salt),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProof, "", "Proof of validation, or the rejection reason")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevealValidation creates a command to reveal a committed verdict
+func CmdRevealValidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-validation [work-id] [valid] [confidence] [salt-hex]",
+		Short: "Reveal a committed verdict during the work unit's reveal phase",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valid, confidence, proof, salt, err := verdictFromArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevealValidation{
+				Validator:  clientCtx.GetFromAddress().String(),
+				WorkId:     args[0],
+				Valid:      valid,
+				Confidence: confidence,
+				Proof:      proof,
+				Salt:       salt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProof, "", "Proof of validation, or the rejection reason, as committed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// verdictFromArgs parses the verdict shared by the commit and reveal commands
+func verdictFromArgs(cmd *cobra.Command, args []string) (bool, uint32, string, []byte, error) {
+	valid, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return false, 0, "", nil, fmt.Errorf("invalid verdict: %w", err)
+	}
+
+	confidence, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		return false, 0, "", nil, fmt.Errorf("invalid confidence: %w", err)
+	}
+
+	salt, err := hex.DecodeString(args[3])
+	if err != nil {
+		return false, 0, "", nil, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	proof, err := cmd.Flags().GetString(FlagProof)
+	if err != nil {
+		return false, 0, "", nil, err
+	}
+
+	return valid, uint32(confidence), proof, salt, nil
+}
+
 // CmdRejectWork creates a command to reject work
 func CmdRejectWork() *cobra.Command {
 	cmd := &cobra.Command{
@@ -115,3 +273,95 @@ func CmdRejectWork() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdChallengeWork creates a command to challenge finalized work
+func CmdChallengeWork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-work [work-id] [bond] [reason]",
+		Short: "Challenge the finalized outcome of a work unit, escrowing a bond",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bond, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid bond: %w", err)
+			}
+
+			msg := &types.MsgChallengeWork{
+				Challenger: clientCtx.GetFromAddress().String(),
+				WorkId:     args[0],
+				Bond:       types.NewProtoCoin(bond),
+				Reason:     args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdBond creates a command to bond validator stake
+func CmdBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond [amount]",
+		Short: "Bond stake to register as or remain a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := &types.MsgBond{
+				Validator: clientCtx.GetFromAddress().String(),
+				Amount:    types.NewProtoCoin(amount),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUnbond creates a command to unbond validator stake
+func CmdUnbond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond [amount]",
+		Short: "Start unbonding validator stake, returned after the unbonding period",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := &types.MsgUnbond{
+				Validator: clientCtx.GetFromAddress().String(),
+				Amount:    types.NewProtoCoin(amount),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
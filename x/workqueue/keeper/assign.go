@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// assignmentCandidate is a validator eligible to be assigned work this block
+type assignmentCandidate struct {
+	address string
+	stats   *types.ValidatorStats
+	load    uint32
+}
+
+// AssignWork leases the highest priority pending work units to validators
+// when assignment is enabled. Each unit goes to a validator drawn at random
+// with a weight that grows with its specialization in the work type, its
+// accuracy and its spare lease capacity. The draw is seeded from the block
+// hash and the work ID, so every node makes the same assignment.
+//
+// Challenged work is never assigned. Its re-validators must not have voted on
+// it originally and volunteer for the re-validation round by claiming it, so
+// the draw, which does not know who voted, stays out of the round.
+func (k Keeper) AssignWork(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.AssignmentEnabled {
+		return nil
+	}
+
+	var pending []*types.WorkUnit
+	k.IteratePendingByPriority(ctx, func(work *types.WorkUnit) bool {
+		pending = append(pending, work)
+		return uint32(len(pending)) >= params.MaxAssignmentsPerBlock
+	})
+	if len(pending) == 0 {
+		return nil
+	}
+
+	candidates := k.assignmentCandidates(ctx, params.MaxValidatorLoad)
+
+	for _, work := range pending {
+		candidate := k.pickValidator(ctx, work, candidates, params.MaxValidatorLoad)
+		if candidate == nil {
+			continue
+		}
+
+		work.AssignedTo = candidate.address
+		work.AssignedAt = ctx.BlockHeight()
+		k.leaseWork(ctx, work, candidate.address)
+		candidate.load++
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkAssigned{
+			WorkId:         work.Id,
+			WorkType:       work.Type,
+			Validator:      candidate.address,
+			LeaseExpiresAt: work.LeaseExpiresAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assignmentCandidates returns the bonded, unjailed validators below the load
+// limit in address order, together with the number of units each holds
+func (k Keeper) assignmentCandidates(ctx sdk.Context, maxLoad uint32) []*assignmentCandidate {
+	load := make(map[string]uint32)
+	k.IterateWorkByStatus(ctx, types.WorkStatusValidating, func(work *types.WorkUnit) bool {
+		load[work.ClaimedBy]++
+		return false
+	})
+
+	var candidates []*assignmentCandidate
+	k.IterateValidatorBonds(ctx, func(bond *types.ValidatorBond) bool {
+		if load[bond.Validator] >= maxLoad || k.checkValidator(ctx, bond.Validator) != nil {
+			return false
+		}
+		stats, found := k.GetValidatorStats(ctx, bond.Validator)
+		if !found {
+			return false
+		}
+		candidates = append(candidates, &assignmentCandidate{
+			address: bond.Validator,
+			stats:   stats,
+			load:    load[bond.Validator],
+		})
+		return false
+	})
+
+	return candidates
+}
+
+// pickValidator draws the validator a work unit is assigned to, or returns
+// nil when no candidate may take it
+func (k Keeper) pickValidator(ctx sdk.Context, work *types.WorkUnit, candidates []*assignmentCandidate, maxLoad uint32) *assignmentCandidate {
+	weights := make([]math.Int, len(candidates))
+	total := math.ZeroInt()
+	for i, candidate := range candidates {
+		weights[i] = math.ZeroInt()
+		if candidate.load >= maxLoad {
+			continue
+		}
+		// A validator votes at most once on a unit, including re-validation
+		if _, voted := k.GetWorkVote(ctx, work.Id, candidate.address); voted {
+			continue
+		}
+		if _, voted := k.GetChallengeVote(ctx, work.Id, candidate.address); voted {
+			continue
+		}
+		if _, committed := k.GetValidationCommit(ctx, work.Id, candidate.address); committed {
+			continue
+		}
+
+		weights[i] = math.NewIntFromUint64(candidate.stats.Specializations[work.Type]).AddRaw(1).
+			Mul(math.NewIntFromUint64(candidate.stats.GetAccuracy()).AddRaw(1)).
+			Mul(math.NewIntFromUint64(uint64(maxLoad - candidate.load)))
+		total = total.Add(weights[i])
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	seed := sha256.New()
+	seed.Write(ctx.HeaderHash())
+	seed.Write(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	seed.Write([]byte(work.Id))
+	draw := math.NewIntFromBigInt(new(big.Int).Mod(new(big.Int).SetBytes(seed.Sum(nil)), total.BigInt()))
+
+	for i, weight := range weights {
+		if draw.LT(weight) {
+			return candidates[i]
+		}
+		draw = draw.Sub(weight)
+	}
+	return nil
+}
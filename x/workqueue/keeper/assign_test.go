@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestAssignWorkIsSeededByBlockHash(t *testing.T) {
+	// Assign ten units among three equal validators in a block with the given
+	// hash, returning the validator each unit went to
+	assign := func(headerHash string) map[string]string {
+		k, ctx, bank := newBankedKeeper()
+		ctx = ctx.WithHeaderHash([]byte(headerHash))
+		updateParams(t, k, ctx, func(params *types.Params) {
+			params.AssignmentEnabled = true
+			params.MaxAssignmentsPerBlock = 10
+			params.MaxValidatorLoad = 10
+		})
+		bondValidators(t, k, ctx, bank, "alice", "bob", "carol")
+
+		var workIDs []string
+		for i := 0; i < 10; i++ {
+			workIDs = append(workIDs, submitWork(t, k, ctx, fmt.Sprintf(`{"block":%d}`, i)))
+		}
+		k.AssignWork(ctx)
+
+		assigned := make(map[string]string)
+		for _, id := range workIDs {
+			work, _ := k.GetWork(ctx, id)
+			if work.Status != types.WorkStatusValidating || work.ClaimedBy != work.AssignedTo || work.AssignedAt != ctx.BlockHeight() {
+				t.Fatalf("work %s is %s claimed by %q and assigned to %q at %d, want leased to its assignee at %d",
+					id, work.Status, work.ClaimedBy, work.AssignedTo, work.AssignedAt, ctx.BlockHeight())
+			}
+			assigned[id] = work.AssignedTo
+		}
+		return assigned
+	}
+
+	first := assign("block hash one")
+
+	// Every node with the same block makes the same assignment
+	for id, validator := range assign("block hash one") {
+		if first[id] != validator {
+			t.Fatalf("work %s went to %s and then %s in the same block", id, first[id], validator)
+		}
+	}
+
+	// Another block hash draws differently
+	differs := false
+	for id, validator := range assign("block hash two") {
+		differs = differs || first[id] != validator
+	}
+	if !differs {
+		t.Fatal("a different block hash made the same assignment")
+	}
+}
+
+func TestAssignWorkWeighsSpecialization(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) {
+		params.AssignmentEnabled = true
+		params.MaxAssignmentsPerBlock = 50
+		params.MaxValidatorLoad = 50
+	})
+	validators := bondValidators(t, k, ctx, bank, "specialist", "newcomer")
+
+	// The specialist has validated 99 crypto units, all accurately
+	stats, _ := k.GetValidatorStats(ctx, validators[0])
+	stats.TotalWorkValidated = 99
+	stats.Specializations = map[string]uint64{types.WorkTypeCrypto: 99}
+	k.SetValidatorStats(ctx, stats)
+
+	for i := 0; i < 50; i++ {
+		submitWork(t, k, ctx, fmt.Sprintf(`{"block":%d}`, i))
+	}
+	k.AssignWork(ctx)
+
+	count := make(map[string]int)
+	k.IterateWorkByStatus(ctx, types.WorkStatusValidating, func(work *types.WorkUnit) bool {
+		count[work.AssignedTo]++
+		return false
+	})
+	if count[validators[0]]+count[validators[1]] != 50 {
+		t.Fatalf("assigned %d units, want all 50", count[validators[0]]+count[validators[1]])
+	}
+	if count[validators[1]] > 5 {
+		t.Fatalf("newcomer was assigned %d of 50 units against a specialist, want at most 5", count[validators[1]])
+	}
+}
+
+func TestAssignWorkRespectsLoadLimit(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) {
+		params.AssignmentEnabled = true
+		params.MaxAssignmentsPerBlock = 10
+		params.MaxValidatorLoad = 2
+	})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob", "jailed")
+
+	// Jailed validators are not assigned work
+	bond, _ := k.GetValidatorBond(ctx, validators[2])
+	bond.JailedUntil = ctx.BlockHeight() + 1
+	k.SetValidatorBond(ctx, bond)
+
+	for i := 0; i < 5; i++ {
+		submitWork(t, k, ctx, fmt.Sprintf(`{"block":%d}`, i))
+	}
+	k.AssignWork(ctx)
+
+	count := make(map[string]int)
+	k.IterateWorkByStatus(ctx, types.WorkStatusValidating, func(work *types.WorkUnit) bool {
+		count[work.AssignedTo]++
+		return false
+	})
+	for _, validator := range validators[:2] {
+		if count[validator] != 2 {
+			t.Fatalf("validator was assigned %d units, want the load limit of 2", count[validator])
+		}
+	}
+	if count[validators[2]] != 0 {
+		t.Fatalf("jailed validator was assigned %d units, want none", count[validators[2]])
+	}
+	if pending := workIDs(func(cb func(*types.WorkUnit) bool) { k.IterateWorkByStatus(ctx, types.WorkStatusPending, cb) }); len(pending) != 1 {
+		t.Fatalf("%d units are left pending, want 1", len(pending))
+	}
+}
+
+func TestAssignWorkServesPriorityOrder(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) {
+		params.AssignmentEnabled = true
+		params.MaxAssignmentsPerBlock = 1
+		params.MaxValidatorLoad = 10
+	})
+	bondValidators(t, k, ctx, bank, "alice")
+	agingBlocks := k.GetParams(ctx).PriorityAgingBlocks
+
+	// Higher priority work submitted later is assigned first
+	bulk := &types.WorkUnit{Type: types.WorkTypeCrypto, Data: []byte(`{"batch":"bulk"}`), Submitter: testAddr("submitter")}
+	urgent := &types.WorkUnit{Type: types.WorkTypeCrypto, Data: []byte(`{"batch":"urgent"}`), Submitter: testAddr("submitter"), Priority: 1}
+	for _, work := range []*types.WorkUnit{bulk, urgent} {
+		if err := k.SubmitWork(ctx, work); err != nil {
+			t.Fatalf("failed to submit work: %v", err)
+		}
+	}
+	k.AssignWork(ctx)
+	if work, _ := k.GetWork(ctx, urgent.Id); work.Status != types.WorkStatusValidating {
+		t.Fatalf("urgent work is %s, want it assigned first", work.Status)
+	}
+	if work, _ := k.GetWork(ctx, bulk.Id); work.Status != types.WorkStatusPending {
+		t.Fatalf("bulk work is %s, want it still pending", work.Status)
+	}
+
+	// Work that has waited longer than a priority level is worth goes ahead
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + agingBlocks + 1)
+	later := &types.WorkUnit{Type: types.WorkTypeCrypto, Data: []byte(`{"batch":"later"}`), Submitter: testAddr("submitter"), Priority: 1}
+	if err := k.SubmitWork(ctx, later); err != nil {
+		t.Fatalf("failed to submit work: %v", err)
+	}
+	k.AssignWork(ctx)
+	if work, _ := k.GetWork(ctx, bulk.Id); work.Status != types.WorkStatusValidating {
+		t.Fatalf("aged bulk work is %s, want it assigned ahead of newer urgent work", work.Status)
+	}
+	if work, _ := k.GetWork(ctx, later.Id); work.Status != types.WorkStatusPending {
+		t.Fatalf("newer urgent work is %s, want it still pending", work.Status)
+	}
+}
+
+func TestAssignWorkDisabled(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	// Assignment is off by default, leaving work to be claimed
+	k.AssignWork(ctx)
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusPending || work.AssignedTo != "" {
+		t.Fatalf("work is %s assigned to %q with assignment disabled, want pending", work.Status, work.AssignedTo)
+	}
+}
+
+func TestAssignWorkSkipsChallengedWork(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 1, RequiredAgreement: 1})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+	castVote(t, k, ctx, workID, validators[0], true)
+
+	challenger := testAddr("challenger")
+	bank.fund(challenger, types.DefaultMinChallengeBond)
+	bond := sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinChallengeBond)
+	if err := k.ChallengeWork(ctx, challenger, workID, bond, "wrong"); err != nil {
+		t.Fatalf("failed to challenge work: %v", err)
+	}
+	updateParams(t, k, ctx, func(params *types.Params) { params.AssignmentEnabled = true })
+
+	// The re-validation round is left to validators who claim it
+	k.AssignWork(ctx)
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusChallenged || work.AssignedTo != "" {
+		t.Fatalf("challenged work is %s assigned to %q, want it challenged and unassigned", work.Status, work.AssignedTo)
+	}
+	if _, err := k.ClaimWork(ctx, workID, validators[1]); err != nil {
+		t.Fatalf("failed to claim challenged work: %v", err)
+	}
+}
@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetValidatorBond retrieves a validator's bond
+func (k Keeper) GetValidatorBond(ctx sdk.Context, validatorAddr string) (*types.ValidatorBond, bool) {
+	return get(ctx, k.bonds, validatorAddr)
+}
+
+// SetValidatorBond stores a validator's bond
+func (k Keeper) SetValidatorBond(ctx sdk.Context, bond *types.ValidatorBond) {
+	must(k.bonds.Set(ctx, bond.Validator, bond))
+}
+
+// IterateValidatorBonds iterates over all validator bonds
+func (k Keeper) IterateValidatorBonds(ctx sdk.Context, cb func(bond *types.ValidatorBond) (stop bool)) {
+	walk(ctx, k.bonds, nil, cb)
+}
+
+// SetUnbondingEntry stores an unbonding entry in the completion queue and
+// indexes it under its validator
+func (k Keeper) SetUnbondingEntry(ctx sdk.Context, entry *types.UnbondingEntry) {
+	must(k.unbonding.Set(ctx, collections.Join(entry.CompletionHeight, entry.Validator), entry))
+	must(k.validatorUnbonding.Set(ctx, collections.Join(entry.Validator, entry.CompletionHeight)))
+}
+
+// removeUnbondingEntry deletes an unbonding entry from the completion queue
+// and its validator index
+func (k Keeper) removeUnbondingEntry(ctx sdk.Context, entry *types.UnbondingEntry) {
+	must(k.unbonding.Remove(ctx, collections.Join(entry.CompletionHeight, entry.Validator)))
+	must(k.validatorUnbonding.Remove(ctx, collections.Join(entry.Validator, entry.CompletionHeight)))
+}
+
+// IterateUnbonding iterates over unbonding entries completing up to and
+// including the given height, in completion order. A negative height iterates
+// over all entries.
+func (k Keeper) IterateUnbonding(ctx sdk.Context, height int64, cb func(entry *types.UnbondingEntry) (stop bool)) {
+	var ranger collections.Ranger[collections.Pair[int64, string]]
+	if height >= 0 {
+		ranger = collections.NewPrefixUntilPairRange[int64, string](height)
+	}
+	walk(ctx, k.unbonding, ranger, cb)
+}
+
+// GetUnbondingEntries returns a validator's unbonding entries in completion
+// order
+func (k Keeper) GetUnbondingEntries(ctx sdk.Context, validatorAddr string) []*types.UnbondingEntry {
+	var entries []*types.UnbondingEntry
+	ranger := collections.NewPrefixedPairRange[string, int64](validatorAddr)
+	err := k.validatorUnbonding.Walk(ctx, ranger, func(key collections.Pair[string, int64]) (bool, error) {
+		if entry, found := k.getUnbondingEntry(ctx, key.K2(), validatorAddr); found {
+			entries = append(entries, entry)
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return entries
+}
+
+// Bond escrows stake in the module account and adds it to a validator's bond.
+// Bonding registers the address as a validator if it is not one already.
+func (k Keeper) Bond(ctx sdk.Context, validatorAddr string, amount sdk.Coin) error {
+	bondDenom := k.GetParams(ctx).BondDenom
+	if amount.Denom != bondDenom || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidBond, "bond must be a positive amount of %s", bondDenom)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	bond, found := k.GetValidatorBond(ctx, validatorAddr)
+	if !found {
+		bond = &types.ValidatorBond{Validator: validatorAddr}
+	}
+	bonded, err := bondedAmount(bond, bondDenom)
+	if err != nil {
+		return err
+	}
+	bond.Amount = types.NewProtoCoin(bonded.Add(amount))
+	k.SetValidatorBond(ctx, bond)
+
+	if !k.IsRegisteredValidator(ctx, validatorAddr) {
+		k.SetValidatorStats(ctx, &types.ValidatorStats{
+			Address:      validatorAddr,
+			LastActiveAt: ctx.BlockHeight(),
+		})
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventValidatorBonded{
+		Validator: validatorAddr,
+		Amount:    types.NewProtoCoin(amount),
+	})
+}
+
+// Unbond moves stake out of a validator's bond into the unbonding queue,
+// returning the height at which it will be paid out. Unbonding stake can
+// still be slashed until then.
+func (k Keeper) Unbond(ctx sdk.Context, validatorAddr string, amount sdk.Coin) (int64, error) {
+	bond, found := k.GetValidatorBond(ctx, validatorAddr)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrInsufficientBond, validatorAddr)
+	}
+
+	bonded, err := bondedAmount(bond, k.GetParams(ctx).BondDenom)
+	if err != nil {
+		return 0, err
+	}
+	if amount.Denom != bonded.Denom || !amount.IsPositive() || bonded.IsLT(amount) {
+		return 0, errorsmod.Wrapf(types.ErrInsufficientBond, "cannot unbond %s from %s", amount, bonded)
+	}
+
+	bond.Amount = types.NewProtoCoin(bonded.Sub(amount))
+	k.SetValidatorBond(ctx, bond)
+
+	// Unbonding in the same block merges into one entry
+	completionHeight := ctx.BlockHeight() + k.GetParams(ctx).UnbondingBlocks
+	entry := &types.UnbondingEntry{
+		Validator:        validatorAddr,
+		Amount:           types.NewProtoCoin(amount),
+		CompletionHeight: completionHeight,
+	}
+	if existing, found := k.getUnbondingEntry(ctx, completionHeight, validatorAddr); found {
+		pending, err := types.SDKCoin(existing.Amount)
+		if err != nil {
+			return 0, err
+		}
+		entry.Amount = types.NewProtoCoin(pending.Add(amount))
+	}
+	k.SetUnbondingEntry(ctx, entry)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorUnbonding{
+		Validator:        validatorAddr,
+		Amount:           types.NewProtoCoin(amount),
+		CompletionHeight: completionHeight,
+	}); err != nil {
+		return 0, err
+	}
+
+	return completionHeight, nil
+}
+
+// CompleteUnbonding returns the stake of every unbonding entry that has
+// matured by the current block height to its validator
+func (k Keeper) CompleteUnbonding(ctx sdk.Context) error {
+	var matured []*types.UnbondingEntry
+	k.IterateUnbonding(ctx, ctx.BlockHeight(), func(entry *types.UnbondingEntry) bool {
+		matured = append(matured, entry)
+		return false
+	})
+
+	for _, entry := range matured {
+		k.removeUnbondingEntry(ctx, entry)
+
+		amount, err := types.SDKCoin(entry.Amount)
+		if err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(entry.Validator)
+		if err != nil {
+			return err
+		}
+		if amount.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(amount)); err != nil {
+				return err
+			}
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorUnbonded{
+			Validator: entry.Validator,
+			Amount:    types.NewProtoCoin(amount),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkValidator ensures an address may claim and vote on work: it must be a
+// registered validator holding at least the minimum bond and not be jailed
+func (k Keeper) checkValidator(ctx sdk.Context, validatorAddr string) error {
+	if !k.IsRegisteredValidator(ctx, validatorAddr) {
+		return errorsmod.Wrap(types.ErrUnknownValidator, validatorAddr)
+	}
+
+	bond, found := k.GetValidatorBond(ctx, validatorAddr)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInsufficientBond, "%s has no bond", validatorAddr)
+	}
+
+	minBond := k.GetParams(ctx).MinValidatorBondCoin()
+	bonded, err := bondedAmount(bond, minBond.Denom)
+	if err != nil {
+		return err
+	}
+	if bonded.Denom != minBond.Denom || bonded.IsLT(minBond) {
+		return errorsmod.Wrapf(types.ErrInsufficientBond, "%s has %s bonded, at least %s required", validatorAddr, bonded, minBond)
+	}
+
+	if ctx.BlockHeight() < bond.JailedUntil {
+		return errorsmod.Wrapf(types.ErrValidatorJailed, "%s until height %d", validatorAddr, bond.JailedUntil)
+	}
+
+	return nil
+}
+
+// slashValidator burns a fraction of a validator's bonded and unbonding stake
+// and jails it on every JailThreshold-th slash
+func (k Keeper) slashValidator(ctx sdk.Context, validatorAddr string) error {
+	bond, found := k.GetValidatorBond(ctx, validatorAddr)
+	if !found {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	fraction := params.SlashFractionDec()
+
+	bonded, err := bondedAmount(bond, params.BondDenom)
+	if err != nil {
+		return err
+	}
+	slashed := sdk.NewCoin(bonded.Denom, math.LegacyNewDecFromInt(bonded.Amount).Mul(fraction).TruncateInt())
+	bond.Amount = types.NewProtoCoin(bonded.Sub(slashed))
+
+	for _, entry := range k.GetUnbondingEntries(ctx, validatorAddr) {
+		pending, err := types.SDKCoin(entry.Amount)
+		if err != nil {
+			return err
+		}
+		cut := sdk.NewCoin(pending.Denom, math.LegacyNewDecFromInt(pending.Amount).Mul(fraction).TruncateInt())
+		entry.Amount = types.NewProtoCoin(pending.Sub(cut))
+		k.SetUnbondingEntry(ctx, entry)
+		slashed = slashed.Add(cut)
+	}
+
+	if slashed.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+			return err
+		}
+	}
+
+	bond.SlashCount++
+	jailed := bond.SlashCount%params.JailThreshold == 0
+	if jailed {
+		bond.JailedUntil = ctx.BlockHeight() + params.JailBlocks
+	}
+	k.SetValidatorBond(ctx, bond)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorSlashed{
+		Validator: validatorAddr,
+		Amount:    types.NewProtoCoin(slashed),
+	}); err != nil {
+		return err
+	}
+
+	if jailed {
+		return ctx.EventManager().EmitTypedEvent(&types.EventValidatorJailed{
+			Validator:   validatorAddr,
+			JailedUntil: bond.JailedUntil,
+		})
+	}
+
+	return nil
+}
+
+// slashDissenters slashes the validators who voted against the outcome of a
+// round that reached quorum. Dissent is only punished when the winning side
+// holds a strict majority of the votes, so that work rejected for low
+// confidence does not slash validators who agreed with each other.
+func (k Keeper) slashDissenters(ctx sdk.Context, votes []*types.WorkVote, tally *types.VoteTally) error {
+	accepted := tally.Accepted()
+	winning, losing := tally.ValidVotes, tally.InvalidVotes
+	if !accepted {
+		winning, losing = losing, winning
+	}
+	if winning <= losing {
+		return nil
+	}
+
+	for _, vote := range votes {
+		if vote.Valid == accepted {
+			continue
+		}
+		if err := k.slashValidator(ctx, vote.Validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getUnbondingEntry retrieves a validator's unbonding entry completing at the
+// given height
+func (k Keeper) getUnbondingEntry(ctx sdk.Context, height int64, validatorAddr string) (*types.UnbondingEntry, bool) {
+	return get(ctx, k.unbonding, collections.Join(height, validatorAddr))
+}
+
+// hasStake reports whether any validator has stake bonded or unbonding
+func (k Keeper) hasStake(ctx sdk.Context) bool {
+	staked := false
+	k.IterateValidatorBonds(ctx, func(bond *types.ValidatorBond) bool {
+		if bond.Amount != nil {
+			bonded, err := types.SDKCoin(bond.Amount)
+			staked = err != nil || bonded.IsPositive()
+		}
+		return staked
+	})
+	if staked {
+		return true
+	}
+	k.IterateUnbonding(ctx, -1, func(*types.UnbondingEntry) bool {
+		staked = true
+		return true
+	})
+	return staked
+}
+
+// bondedAmount returns the stake held by a bond in the given denom, treating
+// an unset or zero amount as zero of it. Stake held in another denom cannot be
+// added to or compared with amounts of the bond denom and is an error.
+func bondedAmount(bond *types.ValidatorBond, denom string) (sdk.Coin, error) {
+	if bond.Amount == nil {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+	bonded, err := types.SDKCoin(bond.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if bonded.IsZero() {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+	if bonded.Denom != denom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidBond, "%s holds %s, not %s", bond.Validator, bonded, denom)
+	}
+	return bonded, nil
+}
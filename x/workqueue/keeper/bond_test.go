@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestBondAndUnbond(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	validator := testAddr("validator")
+	bank.fund(validator, 10_000_000)
+
+	if err := k.Bond(ctx, validator, sdk.NewInt64Coin("ustake", 10_000_000)); !errors.Is(err, types.ErrInvalidBond) {
+		t.Fatalf("bonding another denom returned %v, want %v", err, types.ErrInvalidBond)
+	}
+	if err := k.Bond(ctx, validator, sdk.NewInt64Coin(types.DefaultBondDenom, 10_000_000)); err != nil {
+		t.Fatalf("failed to bond: %v", err)
+	}
+	if !k.IsRegisteredValidator(ctx, validator) {
+		t.Fatal("bonding did not register the validator")
+	}
+	if balance, escrowed := bank.balance(validator), bank.balance(types.ModuleName); balance != 0 || escrowed != 10_000_000 {
+		t.Fatalf("validator holds %d and module %d after bonding, want 0 and 10000000", balance, escrowed)
+	}
+
+	// Unbonding in the same block merges into one entry
+	for _, amount := range []int64{3_000_000, 1_000_000} {
+		completion, err := k.Unbond(ctx, validator, sdk.NewInt64Coin(types.DefaultBondDenom, amount))
+		if err != nil {
+			t.Fatalf("failed to unbond %d: %v", amount, err)
+		}
+		if completion != 1010 {
+			t.Fatalf("unbonding completes at %d, want 1010", completion)
+		}
+	}
+	if _, err := k.Unbond(ctx, validator, sdk.NewInt64Coin(types.DefaultBondDenom, 6_000_001)); !errors.Is(err, types.ErrInsufficientBond) {
+		t.Fatalf("unbonding more than bonded returned %v, want %v", err, types.ErrInsufficientBond)
+	}
+	if stake := bonded(t, k, ctx, validator); stake != 6_000_000 {
+		t.Fatalf("validator has %d bonded, want 6000000", stake)
+	}
+	entries := k.GetUnbondingEntries(ctx, validator)
+	if len(entries) != 1 || entries[0].Amount.Amount != "4000000" || entries[0].CompletionHeight != 1010 {
+		t.Fatalf("unbonding entries are %v, want 4000000 completing at 1010", entries)
+	}
+
+	// Below the minimum bond the validator can no longer claim work
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+	if _, err := k.ClaimWork(ctx, workID, validator); !errors.Is(err, types.ErrInsufficientBond) {
+		t.Fatalf("claiming below the minimum bond returned %v, want %v", err, types.ErrInsufficientBond)
+	}
+
+	// The stake is returned once the unbonding period completes
+	if err := k.CompleteUnbonding(ctx.WithBlockHeight(1009)); err != nil {
+		t.Fatalf("failed to complete unbonding: %v", err)
+	}
+	if balance := bank.balance(validator); balance != 0 {
+		t.Fatalf("validator holds %d before unbonding completes, want 0", balance)
+	}
+	if err := k.CompleteUnbonding(ctx.WithBlockHeight(1010)); err != nil {
+		t.Fatalf("failed to complete unbonding: %v", err)
+	}
+	if balance, escrowed := bank.balance(validator), bank.balance(types.ModuleName); balance != 4_000_000 || escrowed != 6_000_000 {
+		t.Fatalf("validator holds %d and module %d after unbonding, want 4000000 and 6000000", balance, escrowed)
+	}
+	if entries := k.GetUnbondingEntries(ctx, validator); len(entries) != 0 {
+		t.Fatalf("completed unbonding left entries %v", entries)
+	}
+}
+
+func TestUnbondingEntriesByValidator(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob")
+	unbond := func(height int64, validator string) {
+		t.Helper()
+		if _, err := k.Unbond(ctx.WithBlockHeight(height), validator, sdk.NewInt64Coin(types.DefaultBondDenom, 1_000_000)); err != nil {
+			t.Fatalf("failed to unbond: %v", err)
+		}
+	}
+	unbond(10, validators[0])
+	unbond(11, validators[1])
+	unbond(12, validators[0])
+
+	// Each validator sees only its own entries, earliest completion first
+	for i, want := range [][]int64{{1010, 1012}, {1011}} {
+		var heights []int64
+		for _, entry := range k.GetUnbondingEntries(ctx, validators[i]) {
+			if entry.Validator != validators[i] {
+				t.Fatalf("entries of validator %d include one of %s", i, entry.Validator)
+			}
+			heights = append(heights, entry.CompletionHeight)
+		}
+		if !reflect.DeepEqual(heights, want) {
+			t.Fatalf("validator %d unbonds at %v, want %v", i, heights, want)
+		}
+	}
+
+	// Completed entries leave the validator index too
+	if err := k.CompleteUnbonding(ctx.WithBlockHeight(1011)); err != nil {
+		t.Fatalf("failed to complete unbonding: %v", err)
+	}
+	if entries := k.GetUnbondingEntries(ctx, validators[0]); len(entries) != 1 || entries[0].CompletionHeight != 1012 {
+		t.Fatalf("alice has entries %v after the first completed, want one completing at 1012", entries)
+	}
+	if entries := k.GetUnbondingEntries(ctx, validators[1]); len(entries) != 0 {
+		t.Fatalf("bob has entries %v after completing, want none", entries)
+	}
+}
+
+func TestSlashAndJail(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob")
+	dissenter := testAddr("dissenter")
+	bank.fund(dissenter, 20_000_000)
+	if err := k.Bond(ctx, dissenter, sdk.NewInt64Coin(types.DefaultBondDenom, 20_000_000)); err != nil {
+		t.Fatalf("failed to bond: %v", err)
+	}
+	if _, err := k.Unbond(ctx, dissenter, sdk.NewInt64Coin(types.DefaultBondDenom, 2_000_000)); err != nil {
+		t.Fatalf("failed to unbond: %v", err)
+	}
+
+	// Each slash burns 5% of the bonded and the unbonding stake, and every
+	// third one jails the validator
+	want := []struct {
+		bonded, unbonding, burned int64
+		jailed                    bool
+	}{
+		{17_100_000, 1_900_000, 1_000_000, false},
+		{16_245_000, 1_805_000, 1_950_000, false},
+		{15_432_750, 1_714_750, 2_852_500, true},
+	}
+	for i, w := range want {
+		workID := submitWork(t, k, ctx, fmt.Sprintf(`{"block":%d}`, i))
+		castVote(t, k, ctx, workID, dissenter, false)
+		castVote(t, k, ctx, workID, validators[0], true)
+		castVote(t, k, ctx, workID, validators[1], true)
+
+		bond, _ := k.GetValidatorBond(ctx, dissenter)
+		entries := k.GetUnbondingEntries(ctx, dissenter)
+		if stake := bonded(t, k, ctx, dissenter); stake != w.bonded || entries[0].Amount.Amount != fmt.Sprint(w.unbonding) {
+			t.Fatalf("slash %d left %d bonded and %s unbonding, want %d and %d", i+1, stake, entries[0].Amount.Amount, w.bonded, w.unbonding)
+		}
+		if burned := bank.burned.AmountOf(types.DefaultBondDenom).Int64(); burned != w.burned {
+			t.Fatalf("slash %d burned %d in total, want %d", i+1, burned, w.burned)
+		}
+		if bond.SlashCount != uint64(i+1) || (bond.JailedUntil > 0) != w.jailed {
+			t.Fatalf("slash %d left slash count %d and jail height %d", i+1, bond.SlashCount, bond.JailedUntil)
+		}
+	}
+	if escrowed := bank.balance(types.ModuleName); escrowed != 40_000_000-2_852_500 {
+		t.Fatalf("module holds %d, want the 37147500 left after slashing", escrowed)
+	}
+
+	// The agreeing validators are untouched
+	for _, validator := range validators {
+		if stake := bonded(t, k, ctx, validator); stake != 10_000_000 {
+			t.Fatalf("agreeing validator has %d bonded, want 10000000", stake)
+		}
+	}
+
+	// A jailed validator cannot claim work until its jail period ends
+	bond, _ := k.GetValidatorBond(ctx, dissenter)
+	if bond.JailedUntil != ctx.BlockHeight()+types.DefaultJailBlocks {
+		t.Fatalf("validator is jailed until %d, want %d", bond.JailedUntil, ctx.BlockHeight()+types.DefaultJailBlocks)
+	}
+	workID := submitWork(t, k, ctx, `{"block":"next"}`)
+	if _, err := k.ClaimWork(ctx.WithBlockHeight(bond.JailedUntil-1), workID, dissenter); !errors.Is(err, types.ErrValidatorJailed) {
+		t.Fatalf("claiming while jailed returned %v, want %v", err, types.ErrValidatorJailed)
+	}
+	if _, err := k.ClaimWork(ctx.WithBlockHeight(bond.JailedUntil), workID, dissenter); err != nil {
+		t.Fatalf("claiming after the jail period failed: %v", err)
+	}
+}
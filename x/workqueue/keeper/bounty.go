@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	stdmath "math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetBounty retrieves the bounty attached to a work unit
+func (k Keeper) GetBounty(ctx sdk.Context, workID string) (*types.Bounty, bool) {
+	return get(ctx, k.bounties, workID)
+}
+
+// SetBounty stores the bounty attached to a work unit and keeps it in the
+// settlement height index while scheduled
+func (k Keeper) SetBounty(ctx sdk.Context, bounty *types.Bounty) {
+	if old, found := k.GetBounty(ctx, bounty.WorkId); found && scheduled(old) {
+		must(k.bountySettlements.Remove(ctx, collections.Join(old.SettlesAt, old.WorkId)))
+	}
+	if scheduled(bounty) {
+		must(k.bountySettlements.Set(ctx, collections.Join(bounty.SettlesAt, bounty.WorkId)))
+	}
+	must(k.bounties.Set(ctx, bounty.WorkId, bounty))
+}
+
+// scheduled reports whether an escrowed bounty awaits settlement at a height
+func scheduled(bounty *types.Bounty) bool {
+	return bounty.Status == types.BountyStatusEscrowed && bounty.SettlesAt > 0
+}
+
+// IterateBounties iterates over all bounties in work ID order
+func (k Keeper) IterateBounties(ctx sdk.Context, cb func(bounty *types.Bounty) (stop bool)) {
+	walk(ctx, k.bounties, nil, cb)
+}
+
+// IterateDueBounties iterates over all escrowed bounties scheduled to settle
+// at or before the given block height, earliest first. Iteration stops when
+// the callback returns true.
+func (k Keeper) IterateDueBounties(ctx sdk.Context, height int64, cb func(bounty *types.Bounty) (stop bool)) {
+	err := k.bountySettlements.Walk(ctx, heightRange{start: stdmath.MinInt64, end: height}, func(key collections.Pair[int64, string]) (bool, error) {
+		bounty, found := k.GetBounty(ctx, key.K2())
+		if !found {
+			return false, nil
+		}
+		return cb(bounty), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EscrowBounty moves a bounty from the submitter into the module account and
+// attaches it to a work unit until the work is finalized or expires
+func (k Keeper) EscrowBounty(ctx sdk.Context, workID string, submitter string, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidBounty, "bounty must be positive")
+	}
+
+	if _, found := k.GetBounty(ctx, workID); found {
+		return errorsmod.Wrapf(types.ErrInvalidBounty, "%s already has a bounty", workID)
+	}
+
+	submitterAddr, err := sdk.AccAddressFromBech32(submitter)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitterAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	k.SetBounty(ctx, &types.Bounty{
+		WorkId:    workID,
+		Submitter: submitter,
+		Amount:    types.NewProtoCoin(amount),
+		Status:    types.BountyStatusEscrowed,
+	})
+
+	return nil
+}
+
+// scheduleBounty holds the escrowed bounty of newly finalized work until its
+// challenge window closes, so that an overturn settles it by the final
+// outcome
+func (k Keeper) scheduleBounty(ctx sdk.Context, work *types.WorkUnit) {
+	bounty, found := k.GetBounty(ctx, work.Id)
+	if !found || bounty.Status != types.BountyStatusEscrowed {
+		return
+	}
+	bounty.SettlesAt = work.ValidatedAt + k.GetParams(ctx).ChallengeWindow
+	k.SetBounty(ctx, bounty)
+}
+
+// holdBounty takes the bounty of challenged work off the settlement schedule
+// until the challenge is resolved
+func (k Keeper) holdBounty(ctx sdk.Context, workID string) {
+	bounty, found := k.GetBounty(ctx, workID)
+	if !found || !scheduled(bounty) {
+		return
+	}
+	bounty.SettlesAt = 0
+	k.SetBounty(ctx, bounty)
+}
+
+// SettleBounties settles the bounty of every finalized work unit whose
+// challenge window has closed by the current block height
+func (k Keeper) SettleBounties(ctx sdk.Context) error {
+	var due []*types.Bounty
+	k.IterateDueBounties(ctx, ctx.BlockHeight(), func(bounty *types.Bounty) bool {
+		due = append(due, bounty)
+		return false
+	})
+
+	for _, bounty := range due {
+		work, found := k.GetWork(ctx, bounty.WorkId)
+		if !found {
+			return errorsmod.Wrap(types.ErrWorkNotFound, bounty.WorkId)
+		}
+		if err := k.settleBounty(ctx, work, k.GetWorkVotes(ctx, work.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleBounty releases the escrowed bounty of a work unit whose outcome is
+// final. Validated work pays the bounty to the validators who voted it valid,
+// rejected work refunds the submitter less a burned fraction, and expired work
+// refunds the submitter in full.
+func (k Keeper) settleBounty(ctx sdk.Context, work *types.WorkUnit, votes []*types.WorkVote) error {
+	bounty, found := k.GetBounty(ctx, work.Id)
+	if !found || bounty.Status != types.BountyStatusEscrowed {
+		return nil
+	}
+
+	amount, err := types.SDKCoin(bounty.Amount)
+	if err != nil {
+		return err
+	}
+	burned := sdk.NewCoin(amount.Denom, math.ZeroInt())
+
+	switch work.Status {
+	case types.WorkStatusValidated:
+		var winners []*types.WorkVote
+		for _, vote := range votes {
+			if vote.Valid {
+				winners = append(winners, vote)
+			}
+		}
+		if err := k.payVoters(ctx, winners, amount); err != nil {
+			return err
+		}
+		bounty.Status = types.BountyStatusPaid
+
+	case types.WorkStatusRejected, types.WorkStatusExpired:
+		if work.Status == types.WorkStatusRejected {
+			fraction := k.GetParams(ctx).BountyBurnFractionDec()
+			burned = sdk.NewCoin(amount.Denom, math.LegacyNewDecFromInt(amount.Amount).Mul(fraction).TruncateInt())
+			if burned.IsPositive() {
+				if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burned)); err != nil {
+					return err
+				}
+			}
+		}
+
+		refund := amount.Sub(burned)
+		if refund.IsPositive() {
+			submitterAddr, err := sdk.AccAddressFromBech32(bounty.Submitter)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, submitterAddr, sdk.NewCoins(refund)); err != nil {
+				return err
+			}
+		}
+		bounty.Status = types.BountyStatusRefunded
+
+	default:
+		return nil
+	}
+
+	bounty.Burned = types.NewProtoCoin(burned)
+	bounty.SettledAt = ctx.BlockHeight()
+	bounty.SettlesAt = 0
+	k.SetBounty(ctx, bounty)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBountySettled{
+		WorkId: work.Id,
+		Status: bounty.Status,
+		Amount: types.NewProtoCoin(amount),
+		Burned: types.NewProtoCoin(burned),
+	})
+}
+
+// ExpireWork marks every pending work unit whose deadline has passed by the
+// current block height as expired and refunds its bounty
+func (k Keeper) ExpireWork(ctx sdk.Context) error {
+	var expired []*types.WorkUnit
+	k.IterateExpiredWork(ctx, ctx.BlockHeight(), func(work *types.WorkUnit) bool {
+		expired = append(expired, work)
+		return false
+	})
+
+	for _, work := range expired {
+		work.Status = types.WorkStatusExpired
+		work.CommitEndsAt = 0
+		k.SetWork(ctx, work)
+
+		// Commitments from a round that never filled are dropped unpenalized
+		for _, commit := range k.GetValidationCommits(ctx, work.Id) {
+			k.deleteValidationCommit(ctx, commit)
+		}
+
+		if err := k.settleBounty(ctx, work, nil); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkExpired{
+			WorkId:   work.Id,
+			WorkType: work.Type,
+		}); err != nil {
+			return err
+		}
+
+		if err := k.afterWorkFinalized(ctx, work); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestBountySettlement(t *testing.T) {
+	tests := []struct {
+		name      string
+		votes     []bool
+		status    string
+		burned    string
+		submitter int64
+		payouts   []int64
+	}{
+		// Validated work pays the validators who voted it valid once its
+		// challenge window closes
+		{"validated", []bool{true, true, false}, types.BountyStatusPaid, "0", 0, []int64{500_000, 500_000, 0}},
+		// Rejected work burns a fraction and refunds the rest
+		{"rejected", []bool{false, false, true}, types.BountyStatusRefunded, "100000", 900_000, []int64{0, 0, 0}},
+		// Expired work refunds the bounty in full, without waiting for a
+		// challenge window
+		{"expired", nil, types.BountyStatusRefunded, "0", 1_000_000, []int64{0, 0, 0}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, bank := newBankedKeeper()
+			k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+			validators := bondValidators(t, k, ctx, bank, "alice", "bob", "carol")
+
+			submitter := testAddr("submitter")
+			bank.fund(submitter, 1_000_000)
+			res, err := keeper.NewMsgServerImpl(k).SubmitWork(ctx, &types.MsgSubmitWork{
+				Submitter: submitter,
+				WorkType:  types.WorkTypeCrypto,
+				WorkData:  []byte(`{"block":1}`),
+				Bounty:    types.NewProtoCoin(sdk.NewInt64Coin(types.DefaultBondDenom, 1_000_000)),
+			})
+			if err != nil {
+				t.Fatalf("failed to submit work: %v", err)
+			}
+			if balance := bank.balance(submitter); balance != 0 {
+				t.Fatalf("submitter holds %d after escrowing the bounty, want 0", balance)
+			}
+
+			for i, valid := range tc.votes {
+				castVote(t, k, ctx, res.WorkId, validators[i], valid)
+			}
+			if tc.votes != nil {
+				// Finalized work holds its bounty while it can still be
+				// challenged
+				settlesAt := ctx.BlockHeight() + k.GetParams(ctx).ChallengeWindow
+				if err := k.SettleBounties(ctx.WithBlockHeight(settlesAt - 1)); err != nil {
+					t.Fatalf("failed to settle bounties: %v", err)
+				}
+				if bounty, _ := k.GetBounty(ctx, res.WorkId); bounty.Status != types.BountyStatusEscrowed || bounty.SettlesAt != settlesAt {
+					t.Fatalf("bounty is %s settling at %d before the challenge window closed, want escrowed until %d", bounty.Status, bounty.SettlesAt, settlesAt)
+				}
+				if err := k.SettleBounties(ctx.WithBlockHeight(settlesAt)); err != nil {
+					t.Fatalf("failed to settle bounties: %v", err)
+				}
+			} else {
+				deadline := ctx.BlockHeight() + types.DefaultWorkExpiryBlocks
+				if err := k.ExpireWork(ctx.WithBlockHeight(deadline - 1)); err != nil {
+					t.Fatalf("failed to expire work: %v", err)
+				}
+				if bounty, _ := k.GetBounty(ctx, res.WorkId); bounty.Status != types.BountyStatusEscrowed {
+					t.Fatalf("bounty is %s before the work expired, want escrowed", bounty.Status)
+				}
+				if err := k.ExpireWork(ctx.WithBlockHeight(deadline)); err != nil {
+					t.Fatalf("failed to expire work: %v", err)
+				}
+			}
+
+			bounty, _ := k.GetBounty(ctx, res.WorkId)
+			if bounty.Status != tc.status || bounty.Burned.Amount != tc.burned {
+				t.Fatalf("bounty is %s with %s burned, want %s with %s burned", bounty.Status, bounty.Burned.Amount, tc.status, tc.burned)
+			}
+			if balance := bank.balance(submitter); balance != tc.submitter {
+				t.Fatalf("submitter holds %d after settlement, want %d", balance, tc.submitter)
+			}
+			for i, want := range tc.payouts {
+				if balance := bank.balance(validators[i]); balance != want {
+					t.Fatalf("validator %d holds %d after settlement, want %d", i, balance, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBountyRequiresFunds(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	submitter := testAddr("submitter")
+	bank.fund(submitter, 999_999)
+
+	err := k.EscrowBounty(ctx, "work", submitter, sdk.NewInt64Coin(types.DefaultBondDenom, 1_000_000))
+	if !errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+		t.Fatalf("escrowing more than the submitter holds returned %v, want %v", err, sdkerrors.ErrInsufficientFunds)
+	}
+	if err := k.EscrowBounty(ctx, "work", submitter, sdk.NewInt64Coin(types.DefaultBondDenom, 0)); !errors.Is(err, types.ErrInvalidBounty) {
+		t.Fatalf("escrowing an empty bounty returned %v, want %v", err, types.ErrInvalidBounty)
+	}
+
+	// Each work unit carries at most one bounty
+	if err := k.EscrowBounty(ctx, "work", submitter, sdk.NewInt64Coin(types.DefaultBondDenom, 500_000)); err != nil {
+		t.Fatalf("failed to escrow a bounty: %v", err)
+	}
+	if err := k.EscrowBounty(ctx, "work", submitter, sdk.NewInt64Coin(types.DefaultBondDenom, 1)); !errors.Is(err, types.ErrInvalidBounty) {
+		t.Fatalf("escrowing a second bounty returned %v, want %v", err, types.ErrInvalidBounty)
+	}
+	if balance := bank.balance(submitter); balance != 499_999 {
+		t.Fatalf("submitter holds %d, want 499999", balance)
+	}
+}
+
+func TestChallengeSettlesTheBountyByItsOutcome(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob")
+	window := k.GetParams(ctx).ChallengeWindow
+
+	submitter := testAddr("submitter")
+	bank.fund(submitter, 1_000_000)
+	res, err := keeper.NewMsgServerImpl(k).SubmitWork(ctx, &types.MsgSubmitWork{
+		Submitter: submitter,
+		WorkType:  types.WorkTypeCrypto,
+		WorkData:  []byte(`{"block":1}`),
+		Bounty:    types.NewProtoCoin(sdk.NewInt64Coin(types.DefaultBondDenom, 1_000_000)),
+	})
+	if err != nil {
+		t.Fatalf("failed to submit work: %v", err)
+	}
+	castVote(t, k, ctx, res.WorkId, validators[0], true)
+
+	// A challenge takes the bounty off the settlement schedule
+	challenger := testAddr("challenger")
+	bank.fund(challenger, types.DefaultMinChallengeBond)
+	bond := sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinChallengeBond)
+	if err := k.ChallengeWork(ctx, challenger, res.WorkId, bond, "wrong"); err != nil {
+		t.Fatalf("failed to challenge work: %v", err)
+	}
+	if err := k.SettleBounties(ctx.WithBlockHeight(ctx.BlockHeight() + window)); err != nil {
+		t.Fatalf("failed to settle bounties: %v", err)
+	}
+	if bounty, _ := k.GetBounty(ctx, res.WorkId); bounty.Status != types.BountyStatusEscrowed || bounty.SettlesAt != 0 {
+		t.Fatalf("challenged work's bounty is %s settling at %d, want held in escrow", bounty.Status, bounty.SettlesAt)
+	}
+
+	// Overturning the validation settles it as rejected work
+	castVote(t, k, ctx, res.WorkId, validators[1], false)
+	bounty, _ := k.GetBounty(ctx, res.WorkId)
+	if bounty.Status != types.BountyStatusRefunded || bounty.Burned.Amount != "100000" {
+		t.Fatalf("bounty is %s with %s burned after the overturn, want refunded with 100000 burned", bounty.Status, bounty.Burned.Amount)
+	}
+	if balance := bank.balance(submitter); balance != 900_000 {
+		t.Fatalf("submitter holds %d after the overturn, want 900000", balance)
+	}
+	if balance := bank.balance(validators[0]); balance != 0 {
+		t.Fatalf("overturned validator holds %d, want 0", balance)
+	}
+}
@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetChallenge retrieves the challenge raised against a work unit
+func (k Keeper) GetChallenge(ctx sdk.Context, workID string) (*types.Challenge, bool) {
+	return get(ctx, k.challenges, workID)
+}
+
+// SetChallenge stores the challenge raised against a work unit and keeps it
+// in the creation height index while unresolved
+func (k Keeper) SetChallenge(ctx sdk.Context, challenge *types.Challenge) {
+	if old, found := k.GetChallenge(ctx, challenge.WorkId); found && !old.Resolved {
+		must(k.openChallenges.Remove(ctx, collections.Join(old.CreatedAt, old.WorkId)))
+	}
+	if !challenge.Resolved {
+		must(k.openChallenges.Set(ctx, collections.Join(challenge.CreatedAt, challenge.WorkId)))
+	}
+	must(k.challenges.Set(ctx, challenge.WorkId, challenge))
+}
+
+// GetChallengeVote retrieves a validator's re-validation vote on a work unit
+func (k Keeper) GetChallengeVote(ctx sdk.Context, workID, validatorAddr string) (*types.WorkVote, bool) {
+	return get(ctx, k.challengeVotes, collections.Join(workID, validatorAddr))
+}
+
+// SetChallengeVote stores a validator's re-validation vote on a work unit
+func (k Keeper) SetChallengeVote(ctx sdk.Context, vote *types.WorkVote) {
+	must(k.challengeVotes.Set(ctx, collections.Join(vote.WorkId, vote.Validator), vote))
+}
+
+// GetChallengeVotes returns all re-validation votes cast on a work unit,
+// ordered by validator
+func (k Keeper) GetChallengeVotes(ctx sdk.Context, workID string) []*types.WorkVote {
+	var votes []*types.WorkVote
+	walk(ctx, k.challengeVotes, collections.NewPrefixedPairRange[string, string](workID), func(vote *types.WorkVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return votes
+}
+
+// IterateChallenges iterates over all challenges, ordered by work unit
+func (k Keeper) IterateChallenges(ctx sdk.Context, cb func(challenge *types.Challenge) (stop bool)) {
+	walk(ctx, k.challenges, nil, cb)
+}
+
+// IterateChallengeVotes iterates over all re-validation votes, ordered by
+// work unit then validator
+func (k Keeper) IterateChallengeVotes(ctx sdk.Context, cb func(vote *types.WorkVote) (stop bool)) {
+	walk(ctx, k.challengeVotes, nil, cb)
+}
+
+// IterateExpiredChallenges iterates over all unresolved challenges raised at
+// or before the given block height, earliest first. Iteration stops when the
+// callback returns true.
+func (k Keeper) IterateExpiredChallenges(ctx sdk.Context, height int64, cb func(challenge *types.Challenge) (stop bool)) {
+	err := k.openChallenges.Walk(ctx, heightRange{start: math.MinInt64, end: height}, func(key collections.Pair[int64, string]) (bool, error) {
+		challenge, found := k.GetChallenge(ctx, key.K2())
+		if !found {
+			return false, nil
+		}
+		return cb(challenge), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// activeChallenge returns the unresolved challenge raised against a work unit
+func (k Keeper) activeChallenge(ctx sdk.Context, workID string) (*types.Challenge, bool) {
+	challenge, found := k.GetChallenge(ctx, workID)
+	if !found || challenge.Resolved {
+		return nil, false
+	}
+	return challenge, true
+}
+
+// queuedStatus returns the status a work unit waits in while unclaimed
+func (k Keeper) queuedStatus(ctx sdk.Context, workID string) string {
+	if _, active := k.activeChallenge(ctx, workID); active {
+		return types.WorkStatusChallenged
+	}
+	return types.WorkStatusPending
+}
+
+// ChallengeWork disputes the finalized outcome of a work unit. The challenger's
+// bond is escrowed in the module account and the work is queued for
+// re-validation by validators who did not vote on it originally.
+func (k Keeper) ChallengeWork(ctx sdk.Context, challenger string, workID string, bond sdk.Coin, reason string) error {
+	work, found := k.GetWork(ctx, workID)
+	if !found {
+		return errorsmod.Wrap(types.ErrWorkNotFound, workID)
+	}
+
+	if !types.IsFinal(work.Status) {
+		return errorsmod.Wrapf(types.ErrWorkNotFinal, "%s (status: %s)", workID, work.Status)
+	}
+
+	// Each work unit can be challenged once
+	if _, found := k.GetChallenge(ctx, workID); found {
+		return errorsmod.Wrap(types.ErrAlreadyChallenged, workID)
+	}
+
+	params := k.GetParams(ctx)
+	if ctx.BlockHeight() > work.ValidatedAt+params.ChallengeWindow {
+		return errorsmod.Wrapf(types.ErrChallengeClosed, "%s could be challenged until height %d", workID, work.ValidatedAt+params.ChallengeWindow)
+	}
+
+	minBond := params.MinChallengeBondCoin()
+	if bond.Denom != minBond.Denom || bond.IsLT(minBond) {
+		return errorsmod.Wrapf(types.ErrInvalidBond, "challenge bond must be at least %s", minBond)
+	}
+
+	challengerAddr, err := sdk.AccAddressFromBech32(challenger)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, challengerAddr, types.ModuleName, sdk.NewCoins(bond)); err != nil {
+		return err
+	}
+
+	k.SetChallenge(ctx, &types.Challenge{
+		WorkId:         workID,
+		Challenger:     challenger,
+		Bond:           types.NewProtoCoin(bond),
+		Reason:         reason,
+		CreatedAt:      ctx.BlockHeight(),
+		OriginalStatus: work.Status,
+	})
+
+	work.Status = types.WorkStatusChallenged
+	k.SetWork(ctx, work)
+	k.holdBounty(ctx, workID)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventWorkChallenged{
+		WorkId:     workID,
+		Challenger: challenger,
+		Bond:       types.NewProtoCoin(bond),
+		Reason:     reason,
+	})
+}
+
+// tallyChallenge resolves a challenge once the re-validation round reaches
+// quorum. An overturned outcome reverses the work's status and counters,
+// records the overturn against and slashes the original majority and refunds
+// the challenger. An upheld outcome pays the bond to the re-validators who
+// upheld it. Either way the bounty held by the challenge is settled by the
+// final outcome.
+func (k Keeper) tallyChallenge(ctx sdk.Context, work *types.WorkUnit, challenge *types.Challenge) error {
+	votes := k.GetChallengeVotes(ctx, work.Id)
+	tally := types.NewVoteTally(votes, k.GetQuorumRule(ctx, work.Type))
+
+	work.ClaimedBy = ""
+	work.LeaseExpiresAt = 0
+
+	if !tally.Reached() {
+		work.Status = types.WorkStatusChallenged
+		k.SetWork(ctx, work)
+		return nil
+	}
+
+	bond, err := types.SDKCoin(challenge.Bond)
+	if err != nil {
+		return err
+	}
+
+	originalValid := challenge.OriginalStatus == types.WorkStatusValidated
+	overturned := tally.Accepted() != originalValid
+
+	if overturned {
+		applyOutcome(ctx, work, votes, tally)
+		if originalValid {
+			k.DecrementTotalValidated(ctx)
+			k.IncrementTotalRejected(ctx)
+		} else {
+			k.DecrementTotalRejected(ctx)
+			k.IncrementTotalValidated(ctx)
+		}
+
+		// Record the overturn against every original vote for the old outcome
+		for _, vote := range k.GetWorkVotes(ctx, work.Id) {
+			if vote.Valid != originalValid {
+				continue
+			}
+			if stats, found := k.GetValidatorStats(ctx, vote.Validator); found {
+				stats.TotalOverturned++
+				k.SetValidatorStats(ctx, stats)
+			}
+			if err := k.slashValidator(ctx, vote.Validator); err != nil {
+				return err
+			}
+		}
+
+		challengerAddr, err := sdk.AccAddressFromBech32(challenge.Challenger)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challengerAddr, sdk.NewCoins(bond)); err != nil {
+			return err
+		}
+	} else {
+		work.Status = challenge.OriginalStatus
+
+		// The bond goes to the re-validators who upheld the outcome, not to
+		// the dissenters slashed below
+		var majority []*types.WorkVote
+		for _, vote := range votes {
+			if vote.Valid == tally.Accepted() {
+				majority = append(majority, vote)
+			}
+		}
+		if err := k.payVoters(ctx, majority, bond); err != nil {
+			return err
+		}
+	}
+
+	if err := k.slashDissenters(ctx, votes, tally); err != nil {
+		return err
+	}
+
+	challenge.Resolved = true
+	challenge.Overturned = overturned
+	challenge.ResolvedAt = ctx.BlockHeight()
+	k.SetChallenge(ctx, challenge)
+	k.SetWork(ctx, work)
+
+	// The outcome is final now: an overturned one pays the bounty to the
+	// re-validators, an upheld one to the original voters
+	bountyVotes := votes
+	if !overturned {
+		bountyVotes = k.GetWorkVotes(ctx, work.Id)
+	}
+	if err := k.settleBounty(ctx, work, bountyVotes); err != nil {
+		return err
+	}
+
+	// Only an overturn changes the outcome downstream modules have seen
+	if overturned {
+		if err := k.afterWorkOverturned(ctx, work, challenge.OriginalStatus); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChallengeResolved{
+		WorkId:     work.Id,
+		Challenger: challenge.Challenger,
+		Status:     work.Status,
+		Overturned: overturned,
+	})
+}
+
+// ExpireChallenges ends every re-validation round that has not reached quorum
+// within the challenge window of its challenge. The challenger's bond is
+// refunded, the disputed status restored and its bounty settled, and
+// unrevealed commitments of the round are dropped unpenalized.
+func (k Keeper) ExpireChallenges(ctx sdk.Context) error {
+	var expired []*types.Challenge
+	k.IterateExpiredChallenges(ctx, ctx.BlockHeight()-k.GetParams(ctx).ChallengeWindow, func(challenge *types.Challenge) bool {
+		expired = append(expired, challenge)
+		return false
+	})
+
+	for _, challenge := range expired {
+		work, found := k.GetWork(ctx, challenge.WorkId)
+		if !found {
+			return errorsmod.Wrap(types.ErrWorkNotFound, challenge.WorkId)
+		}
+
+		bond, err := types.SDKCoin(challenge.Bond)
+		if err != nil {
+			return err
+		}
+		challengerAddr, err := sdk.AccAddressFromBech32(challenge.Challenger)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challengerAddr, sdk.NewCoins(bond)); err != nil {
+			return err
+		}
+
+		for _, commit := range k.GetValidationCommits(ctx, work.Id) {
+			k.deleteValidationCommit(ctx, commit)
+		}
+
+		challenge.Resolved = true
+		challenge.ResolvedAt = ctx.BlockHeight()
+		k.SetChallenge(ctx, challenge)
+
+		work.Status = challenge.OriginalStatus
+		work.ClaimedBy = ""
+		work.LeaseExpiresAt = 0
+		work.CommitEndsAt = 0
+		work.RevealEndsAt = 0
+		k.SetWork(ctx, work)
+
+		if err := k.settleBounty(ctx, work, k.GetWorkVotes(ctx, work.Id)); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventChallengeExpired{
+			WorkId:     work.Id,
+			Challenger: challenge.Challenger,
+			Bond:       challenge.Bond,
+			Status:     work.Status,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payVoters splits an amount held by the module account evenly between the
+// voters, giving any remainder to the first voter
+func (k Keeper) payVoters(ctx sdk.Context, votes []*types.WorkVote, amount sdk.Coin) error {
+	if len(votes) == 0 || !amount.IsPositive() {
+		return nil
+	}
+
+	share := amount.Amount.QuoRaw(int64(len(votes)))
+	remainder := amount.Amount.Sub(share.MulRaw(int64(len(votes))))
+
+	for i, vote := range votes {
+		payout := share
+		if i == 0 {
+			payout = payout.Add(remainder)
+		}
+		if !payout.IsPositive() {
+			continue
+		}
+
+		voterAddr, err := sdk.AccAddressFromBech32(vote.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, voterAddr, sdk.NewCoins(sdk.NewCoin(amount.Denom, payout))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestChallengeResolution(t *testing.T) {
+	tests := []struct {
+		name       string
+		revotes    []bool
+		status     string
+		overturned bool
+		challenger int64
+		paid       []int64
+		burned     int64
+		validated  uint64
+		rejected   uint64
+	}{
+		// The bond is split between the re-validators who upheld the outcome
+		// and the dissenting one slashed
+		{"upheld", []bool{true, true, false}, types.WorkStatusValidated, false, 4_000_000, []int64{500_000, 500_000, 0}, 500_000, 1, 0},
+		// The challenger is refunded, the original majority slashed and the
+		// counters reversed
+		{"overturned", []bool{false, false, false}, types.WorkStatusRejected, true, 5_000_000, []int64{0, 0, 0}, 1_500_000, 0, 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, bank := newBankedKeeper()
+			k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+			bondValidators(t, k, ctx, bank, "v1", "v2", "v3", "v4", "v5", "v6")
+			original := []string{testAddr("v1"), testAddr("v2"), testAddr("v3")}
+			revalidators := []string{testAddr("v4"), testAddr("v5"), testAddr("v6")}
+
+			workID := submitWork(t, k, ctx, `{"block":1}`)
+			for _, validator := range original {
+				castVote(t, k, ctx, workID, validator, true)
+			}
+
+			challenger := testAddr("challenger")
+			bank.fund(challenger, 5_000_000)
+			bond := sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinChallengeBond)
+			if err := k.ChallengeWork(ctx, challenger, workID, bond, "wrong"); err != nil {
+				t.Fatalf("failed to challenge work: %v", err)
+			}
+			if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusChallenged {
+				t.Fatalf("challenged work is %s, want %s", work.Status, types.WorkStatusChallenged)
+			}
+			if balance := bank.balance(types.ModuleName); balance != 6*types.DefaultMinValidatorBond+types.DefaultMinChallengeBond {
+				t.Fatalf("module escrows %d, want the bonded stake and the %d bond", balance, types.DefaultMinChallengeBond)
+			}
+
+			// The original voters cannot re-validate their own outcome
+			if _, err := k.ClaimWork(ctx, workID, original[0]); !errors.Is(err, types.ErrAlreadyVoted) {
+				t.Fatalf("original voter claiming challenged work returned %v, want %v", err, types.ErrAlreadyVoted)
+			}
+
+			for i, valid := range tc.revotes {
+				castVote(t, k, ctx, workID, revalidators[i], valid)
+			}
+
+			work, _ := k.GetWork(ctx, workID)
+			challenge, _ := k.GetChallenge(ctx, workID)
+			if work.Status != tc.status || !challenge.Resolved || challenge.Overturned != tc.overturned {
+				t.Fatalf("work is %s and challenge resolved %t overturned %t, want %s, resolved and overturned %t",
+					work.Status, challenge.Resolved, challenge.Overturned, tc.status, tc.overturned)
+			}
+			if balance := bank.balance(challenger); balance != tc.challenger {
+				t.Fatalf("challenger holds %d, want %d", balance, tc.challenger)
+			}
+			for i, validator := range revalidators {
+				if balance := bank.balance(validator); balance != tc.paid[i] {
+					t.Fatalf("re-validator %d holds %d, want %d", i, balance, tc.paid[i])
+				}
+			}
+			if burned := bank.burned.AmountOf(types.DefaultBondDenom).Int64(); burned != tc.burned {
+				t.Fatalf("%d was burned, want %d", burned, tc.burned)
+			}
+			if balance := bank.balance(types.ModuleName); balance != 6*types.DefaultMinValidatorBond-tc.burned {
+				t.Fatalf("module holds %d after resolution, want only the stake left bonded", balance)
+			}
+			for _, validator := range original {
+				stats, _ := k.GetValidatorStats(ctx, validator)
+				if overturned := stats.TotalOverturned == 1; overturned != tc.overturned {
+					t.Fatalf("original voter has %d overturns recorded, want overturned %t", stats.TotalOverturned, tc.overturned)
+				}
+				if slashed := bonded(t, k, ctx, validator) < types.DefaultMinValidatorBond; slashed != tc.overturned {
+					t.Fatalf("original voter has %d bonded, want slashed %t", bonded(t, k, ctx, validator), tc.overturned)
+				}
+			}
+			if validated, rejected := k.GetTotalWorkValidated(ctx), k.GetTotalWorkRejected(ctx); validated != tc.validated || rejected != tc.rejected {
+				t.Fatalf("counters are %d validated and %d rejected, want %d and %d", validated, rejected, tc.validated, tc.rejected)
+			}
+
+			// Resolved work cannot be challenged again
+			if err := k.ChallengeWork(ctx, challenger, workID, bond, "again"); !errors.Is(err, types.ErrAlreadyChallenged) {
+				t.Fatalf("challenging work twice returned %v, want %v", err, types.ErrAlreadyChallenged)
+			}
+		})
+	}
+}
+
+func TestChallengeWorkRequirements(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice", "bob")
+	challenger := testAddr("challenger")
+	bank.fund(challenger, 5_000_000)
+	bond := sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinChallengeBond)
+
+	pendingID := submitWork(t, k, ctx, `{"block":1}`)
+	finalID := submitWork(t, k, ctx, `{"block":2}`)
+	castVote(t, k, ctx, finalID, testAddr("alice"), true)
+	closes := ctx.BlockHeight() + types.DefaultChallengeWindow
+
+	refused := []struct {
+		name    string
+		workID  string
+		bond    sdk.Coin
+		height  int64
+		wantErr error
+	}{
+		{"missing work", "missing", bond, ctx.BlockHeight(), types.ErrWorkNotFound},
+		{"pending work", pendingID, bond, ctx.BlockHeight(), types.ErrWorkNotFinal},
+		{"bond below minimum", finalID, bond.SubAmount(bond.Amount.QuoRaw(2)), ctx.BlockHeight(), types.ErrInvalidBond},
+		{"bond in another denom", finalID, sdk.NewInt64Coin("ustake", types.DefaultMinChallengeBond), ctx.BlockHeight(), types.ErrInvalidBond},
+		{"window closed", finalID, bond, closes + 1, types.ErrChallengeClosed},
+	}
+	for _, tc := range refused {
+		err := k.ChallengeWork(ctx.WithBlockHeight(tc.height), challenger, tc.workID, tc.bond, "wrong")
+		if !errors.Is(err, tc.wantErr) {
+			t.Fatalf("challenging %s returned %v, want %v", tc.name, err, tc.wantErr)
+		}
+	}
+	if balance := bank.balance(challenger); balance != 5_000_000 {
+		t.Fatalf("challenger holds %d after refused challenges, want 5000000", balance)
+	}
+
+	// The window is open up to its last block, and challenged work is not
+	// final until re-validated
+	if err := k.ChallengeWork(ctx.WithBlockHeight(closes), challenger, finalID, bond, "wrong"); err != nil {
+		t.Fatalf("failed to challenge work on the window's last block: %v", err)
+	}
+	if err := k.ChallengeWork(ctx.WithBlockHeight(closes), challenger, finalID, bond, "again"); !errors.Is(err, types.ErrWorkNotFinal) {
+		t.Fatalf("challenging work under challenge returned %v, want %v", err, types.ErrWorkNotFinal)
+	}
+
+	// An expired re-validation lease returns the work to the challenged queue
+	expiry, err := k.ClaimWork(ctx, finalID, testAddr("bob"))
+	if err != nil {
+		t.Fatalf("failed to claim challenged work: %v", err)
+	}
+	k.ExpireLeases(ctx.WithBlockHeight(expiry))
+	if work, _ := k.GetWork(ctx, finalID); work.Status != types.WorkStatusChallenged || work.ClaimedBy != "" {
+		t.Fatalf("work is %s leased to %q after its re-validation lease expired, want challenged", work.Status, work.ClaimedBy)
+	}
+}
+
+func TestChallengeExpiresShortOfQuorum(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	validators := bondValidators(t, k, ctx, bank, "v1", "v2", "v3", "v4")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+	for _, validator := range validators[:3] {
+		castVote(t, k, ctx, workID, validator, true)
+	}
+
+	challenger := testAddr("challenger")
+	bank.fund(challenger, 5_000_000)
+	bond := sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinChallengeBond)
+	if err := k.ChallengeWork(ctx, challenger, workID, bond, "wrong"); err != nil {
+		t.Fatalf("failed to challenge work: %v", err)
+	}
+	window := k.GetParams(ctx).ChallengeWindow
+	challengedAt := ctx.BlockHeight()
+
+	// A re-validation round short of quorum stays open until the window
+	// closes
+	castVote(t, k, ctx, workID, validators[3], false)
+	if err := k.ExpireChallenges(ctx.WithBlockHeight(challengedAt + window - 1)); err != nil {
+		t.Fatalf("failed to expire challenges: %v", err)
+	}
+	if challenge, _ := k.GetChallenge(ctx, workID); challenge.Resolved {
+		t.Fatal("challenge expired before its window closed")
+	}
+
+	// Then the bond is refunded, the disputed status restored and the lone
+	// re-validator left unpaid and unslashed
+	if err := k.ExpireChallenges(ctx.WithBlockHeight(challengedAt + window)); err != nil {
+		t.Fatalf("failed to expire challenges: %v", err)
+	}
+	work, _ := k.GetWork(ctx, workID)
+	challenge, _ := k.GetChallenge(ctx, workID)
+	if work.Status != types.WorkStatusValidated || !challenge.Resolved || challenge.Overturned {
+		t.Fatalf("work is %s and challenge resolved %t overturned %t, want validated, resolved and not overturned",
+			work.Status, challenge.Resolved, challenge.Overturned)
+	}
+	if balance := bank.balance(challenger); balance != 5_000_000 {
+		t.Fatalf("challenger holds %d after the window closed, want the refunded 5000000", balance)
+	}
+	if balance, stake := bank.balance(validators[3]), bonded(t, k, ctx, validators[3]); balance != 0 || stake != types.DefaultMinValidatorBond {
+		t.Fatalf("re-validator of the expired round holds %d with %d bonded, want nothing paid or slashed", balance, stake)
+	}
+	if balance := bank.balance(types.ModuleName); balance != 4*types.DefaultMinValidatorBond {
+		t.Fatalf("module holds %d, want only the bonded stake", balance)
+	}
+	if validated := k.GetTotalWorkValidated(ctx); validated != 1 {
+		t.Fatalf("total validated is %d after the challenge expired, want 1", validated)
+	}
+}
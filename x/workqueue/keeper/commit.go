@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetValidationCommit retrieves a validator's unrevealed commitment on a work
+// unit
+func (k Keeper) GetValidationCommit(ctx sdk.Context, workID, validatorAddr string) (*types.ValidationCommit, bool) {
+	return get(ctx, k.validationCommits, collections.Join(workID, validatorAddr))
+}
+
+// SetValidationCommit stores a validator's commitment on a work unit
+func (k Keeper) SetValidationCommit(ctx sdk.Context, commit *types.ValidationCommit) {
+	must(k.validationCommits.Set(ctx, collections.Join(commit.WorkId, commit.Validator), commit))
+}
+
+// deleteValidationCommit removes a revealed or expired commitment
+func (k Keeper) deleteValidationCommit(ctx sdk.Context, commit *types.ValidationCommit) {
+	must(k.validationCommits.Remove(ctx, collections.Join(commit.WorkId, commit.Validator)))
+}
+
+// GetValidationCommits returns the unrevealed commitments on a work unit,
+// ordered by validator
+func (k Keeper) GetValidationCommits(ctx sdk.Context, workID string) []*types.ValidationCommit {
+	var commits []*types.ValidationCommit
+	walk(ctx, k.validationCommits, collections.NewPrefixedPairRange[string, string](workID), func(commit *types.ValidationCommit) bool {
+		commits = append(commits, commit)
+		return false
+	})
+	return commits
+}
+
+// IterateValidationCommits iterates over all unrevealed commitments, ordered
+// by work unit then validator
+func (k Keeper) IterateValidationCommits(ctx sdk.Context, cb func(commit *types.ValidationCommit) (stop bool)) {
+	walk(ctx, k.validationCommits, nil, cb)
+}
+
+// currentRoundVotes returns the votes cast in the work unit's current round:
+// the re-validation round while a challenge is active, the original round
+// otherwise
+func (k Keeper) currentRoundVotes(ctx sdk.Context, workID string) []*types.WorkVote {
+	if _, active := k.activeChallenge(ctx, workID); active {
+		return k.GetChallengeVotes(ctx, workID)
+	}
+	return k.GetWorkVotes(ctx, workID)
+}
+
+// CommitValidation seals the lease holder's verdict on a claimed work unit and
+// releases the lease. The first commitment of a round opens its commit window
+// of CommitBlocks. Once the window closes, or earlier once the votes and
+// commitments of the round reach the quorum's required votes, the work enters
+// its reveal phase and no further verdicts are committed until it ends, so no
+// validator can see a verdict before sealing its own.
+func (k Keeper) CommitValidation(ctx sdk.Context, workID string, validatorAddr string, commitment []byte) error {
+	params := k.GetParams(ctx)
+	if !params.CommitRevealEnabled {
+		return errorsmod.Wrap(types.ErrCommitRevealDisabled, "submit the verdict with MsgValidateWork")
+	}
+
+	if err := k.checkValidator(ctx, validatorAddr); err != nil {
+		return err
+	}
+
+	work, found := k.GetWork(ctx, workID)
+	if !found {
+		return errorsmod.Wrap(types.ErrWorkNotFound, workID)
+	}
+
+	// Only the lease holder may commit a verdict
+	if err := k.checkLease(ctx, work, validatorAddr); err != nil {
+		return err
+	}
+
+	if len(commitment) != sha256.Size {
+		return errorsmod.Wrapf(types.ErrInvalidCommitment, "commitment must be %d bytes", sha256.Size)
+	}
+
+	k.SetValidationCommit(ctx, &types.ValidationCommit{
+		WorkId:      workID,
+		Validator:   validatorAddr,
+		Commitment:  commitment,
+		CommittedAt: ctx.BlockHeight(),
+	})
+
+	work.ClaimedBy = ""
+	work.LeaseExpiresAt = 0
+	work.Status = k.queuedStatus(ctx, workID)
+	if work.CommitEndsAt == 0 {
+		work.CommitEndsAt = ctx.BlockHeight() + params.CommitBlocks
+	}
+	k.SetWork(ctx, work)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventValidationCommitted{
+		WorkId:    workID,
+		Validator: validatorAddr,
+	}); err != nil {
+		return err
+	}
+
+	// A full round is revealed without waiting for its commit window to close
+	seats := len(k.currentRoundVotes(ctx, workID)) + len(k.GetValidationCommits(ctx, workID))
+	if uint32(seats) >= k.GetQuorumRule(ctx, work.Type).RequiredVotes {
+		return k.openReveal(ctx, work)
+	}
+
+	return nil
+}
+
+// CloseCommitWindows opens the reveal phase of every work unit whose commit
+// window has closed by the current block height, however few commitments its
+// round collected. A lease still held on the work is released unused.
+func (k Keeper) CloseCommitWindows(ctx sdk.Context) error {
+	var closed []*types.WorkUnit
+	k.IterateClosedCommitWindows(ctx, ctx.BlockHeight(), func(work *types.WorkUnit) bool {
+		closed = append(closed, work)
+		return false
+	})
+
+	for _, work := range closed {
+		if err := k.openReveal(ctx, work); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevealValidation reveals a committed verdict during the work unit's reveal
+// phase and records it as a vote. The round is tallied as soon as every
+// commitment is revealed.
+func (k Keeper) RevealValidation(ctx sdk.Context, workID string, validatorAddr string, valid bool, confidence uint32, proof string, salt []byte) error {
+	work, found := k.GetWork(ctx, workID)
+	if !found {
+		return errorsmod.Wrap(types.ErrWorkNotFound, workID)
+	}
+
+	if work.Status != types.WorkStatusRevealing || ctx.BlockHeight() > work.RevealEndsAt {
+		return errorsmod.Wrapf(types.ErrNotRevealing, "%s (status: %s)", workID, work.Status)
+	}
+
+	commit, found := k.GetValidationCommit(ctx, workID, validatorAddr)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCommitNotFound, "%s on %s", validatorAddr, workID)
+	}
+
+	if confidence > 100 {
+		return errorsmod.Wrap(types.ErrInvalidConfidence, "confidence cannot exceed 100")
+	}
+
+	if !bytes.Equal(types.ValidationCommitment(workID, validatorAddr, valid, confidence, proof, salt), commit.Commitment) {
+		return errorsmod.Wrapf(types.ErrInvalidCommitment, "reveal does not match the commitment of %s on %s", validatorAddr, workID)
+	}
+
+	k.deleteValidationCommit(ctx, commit)
+	if err := k.castVerdict(ctx, work, validatorAddr, valid, confidence, proof); err != nil {
+		return err
+	}
+
+	if len(k.GetValidationCommits(ctx, workID)) > 0 {
+		return nil
+	}
+	return k.closeReveal(ctx, work)
+}
+
+// ExpireReveals ends the reveal phase of every work unit whose deadline has
+// passed by the current block height. Validators who did not reveal their
+// commitment are slashed and only the revealed votes are tallied.
+func (k Keeper) ExpireReveals(ctx sdk.Context) error {
+	var expired []*types.WorkUnit
+	k.IterateExpiredReveals(ctx, ctx.BlockHeight(), func(work *types.WorkUnit) bool {
+		expired = append(expired, work)
+		return false
+	})
+
+	for _, work := range expired {
+		for _, commit := range k.GetValidationCommits(ctx, work.Id) {
+			k.deleteValidationCommit(ctx, commit)
+			if err := k.slashValidator(ctx, commit.Validator); err != nil {
+				return err
+			}
+
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventValidationUnrevealed{
+				WorkId:    work.Id,
+				Validator: commit.Validator,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := k.closeReveal(ctx, work); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openReveal ends a work unit's commit window and starts its reveal phase
+func (k Keeper) openReveal(ctx sdk.Context, work *types.WorkUnit) error {
+	work.Status = types.WorkStatusRevealing
+	work.ClaimedBy = ""
+	work.LeaseExpiresAt = 0
+	work.CommitEndsAt = 0
+	work.RevealEndsAt = ctx.BlockHeight() + k.GetParams(ctx).RevealBlocks
+	k.SetWork(ctx, work)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventWorkRevealStarted{
+		WorkId:       work.Id,
+		RevealEndsAt: work.RevealEndsAt,
+	})
+}
+
+// closeReveal ends a work unit's reveal phase and tallies the revealed votes,
+// finalizing the work or returning it to its queue for another round
+func (k Keeper) closeReveal(ctx sdk.Context, work *types.WorkUnit) error {
+	work.RevealEndsAt = 0
+	return k.tallyVotes(ctx, work)
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// salt is the salt every test commitment is sealed with
+var salt = []byte("sixteen byte salt")
+
+func TestCommitReveal(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) { params.CommitRevealEnabled = true })
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob", "carol", "dave")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	// Verdicts cannot be cast in the open
+	for _, validator := range validators[:3] {
+		if _, err := k.ClaimWork(ctx, workID, validator); err != nil {
+			t.Fatalf("%s failed to claim work: %v", validator, err)
+		}
+		if validator == validators[0] {
+			if err := k.ValidateWork(ctx, workID, validator, true, 90, "proof"); !errors.Is(err, types.ErrCommitRevealRequired) {
+				t.Fatalf("validating in the open returned %v, want %v", err, types.ErrCommitRevealRequired)
+			}
+		}
+		valid := validator != validators[2]
+		commitment := types.ValidationCommitment(workID, validator, valid, 90, "proof", salt)
+		if err := k.CommitValidation(ctx, workID, validator, commitment); err != nil {
+			t.Fatalf("%s failed to commit: %v", validator, err)
+		}
+
+		// Nothing is revealed before the round fills
+		if validator == validators[0] {
+			if err := k.RevealValidation(ctx, workID, validator, true, 90, "proof", salt); !errors.Is(err, types.ErrNotRevealing) {
+				t.Fatalf("revealing before the round filled returned %v, want %v", err, types.ErrNotRevealing)
+			}
+		}
+	}
+
+	// A full round enters its reveal phase and cannot be claimed
+	work, _ := k.GetWork(ctx, workID)
+	if want := ctx.BlockHeight() + k.GetParams(ctx).RevealBlocks; work.Status != types.WorkStatusRevealing || work.RevealEndsAt != want || work.CommitEndsAt != 0 {
+		t.Fatalf("full round is %s revealing until %d with commit window %d, want revealing until %d with no window",
+			work.Status, work.RevealEndsAt, work.CommitEndsAt, want)
+	}
+	if _, err := k.ClaimWork(ctx, workID, validators[3]); !errors.Is(err, types.ErrWorkNotPending) {
+		t.Fatalf("claiming during the reveal phase returned %v, want %v", err, types.ErrWorkNotPending)
+	}
+
+	// Reveals that do not match the commitment are refused
+	otherSalt := []byte("another byte salt")
+	for name, reveal := range map[string]func() error{
+		"flipped verdict":    func() error { return k.RevealValidation(ctx, workID, validators[0], false, 90, "proof", salt) },
+		"changed confidence": func() error { return k.RevealValidation(ctx, workID, validators[0], true, 80, "proof", salt) },
+		"changed proof":      func() error { return k.RevealValidation(ctx, workID, validators[0], true, 90, "other", salt) },
+		"wrong salt":         func() error { return k.RevealValidation(ctx, workID, validators[0], true, 90, "proof", otherSalt) },
+	} {
+		if err := reveal(); !errors.Is(err, types.ErrInvalidCommitment) {
+			t.Fatalf("revealing with a %s returned %v, want %v", name, err, types.ErrInvalidCommitment)
+		}
+	}
+	if err := k.RevealValidation(ctx, workID, validators[3], true, 90, "proof", salt); !errors.Is(err, types.ErrCommitNotFound) {
+		t.Fatalf("revealing without a commitment returned %v, want %v", err, types.ErrCommitNotFound)
+	}
+
+	// The round is tallied once every commitment is revealed
+	for i, valid := range []bool{true, true, false} {
+		if err := k.RevealValidation(ctx, workID, validators[i], valid, 90, "proof", salt); err != nil {
+			t.Fatalf("failed to reveal: %v", err)
+		}
+	}
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusValidated || work.RevealEndsAt != 0 {
+		t.Fatalf("revealed round is %s revealing until %d, want validated", work.Status, work.RevealEndsAt)
+	}
+	if commits := k.GetValidationCommits(ctx, workID); len(commits) != 0 {
+		t.Fatalf("tallied round left commitments %v", commits)
+	}
+	if votes := k.GetWorkVotes(ctx, workID); len(votes) != 3 {
+		t.Fatalf("tallied round recorded %d votes, want 3", len(votes))
+	}
+}
+
+func TestUnrevealedCommitmentsAreSlashed(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) { params.CommitRevealEnabled = true })
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob", "carol")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	for _, validator := range validators {
+		if _, err := k.ClaimWork(ctx, workID, validator); err != nil {
+			t.Fatalf("%s failed to claim work: %v", validator, err)
+		}
+		commitment := types.ValidationCommitment(workID, validator, true, 90, "proof", salt)
+		if err := k.CommitValidation(ctx, workID, validator, commitment); err != nil {
+			t.Fatalf("%s failed to commit: %v", validator, err)
+		}
+	}
+	work, _ := k.GetWork(ctx, workID)
+	for _, validator := range validators[:2] {
+		if err := k.RevealValidation(ctx, workID, validator, true, 90, "proof", salt); err != nil {
+			t.Fatalf("failed to reveal: %v", err)
+		}
+	}
+
+	// Reveals after the phase ends are refused
+	late := ctx.WithBlockHeight(work.RevealEndsAt + 1)
+	if err := k.RevealValidation(late, workID, validators[2], true, 90, "proof", salt); !errors.Is(err, types.ErrNotRevealing) {
+		t.Fatalf("revealing after the phase ended returned %v, want %v", err, types.ErrNotRevealing)
+	}
+
+	// The withheld commitment is slashed and the round, short of quorum
+	// without it, returns to the queue
+	if err := k.ExpireReveals(ctx.WithBlockHeight(work.RevealEndsAt)); err != nil {
+		t.Fatalf("failed to expire reveals: %v", err)
+	}
+	if stake := bonded(t, k, ctx, validators[2]); stake != 9_500_000 {
+		t.Fatalf("validator who withheld its reveal has %d bonded, want 9500000", stake)
+	}
+	if burned := bank.burned.AmountOf(types.DefaultBondDenom).Int64(); burned != 500_000 {
+		t.Fatalf("%d was burned, want 500000", burned)
+	}
+	for _, validator := range validators[:2] {
+		if stake := bonded(t, k, ctx, validator); stake != 10_000_000 {
+			t.Fatalf("validator who revealed has %d bonded, want 10000000", stake)
+		}
+	}
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusPending || work.RevealEndsAt != 0 {
+		t.Fatalf("expired round is %s revealing until %d, want pending", work.Status, work.RevealEndsAt)
+	}
+	if commits := k.GetValidationCommits(ctx, workID); len(commits) != 0 {
+		t.Fatalf("expired round left commitments %v", commits)
+	}
+}
+
+func TestCommitWindowOpensReveal(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	updateParams(t, k, ctx, func(params *types.Params) { params.CommitRevealEnabled = true })
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	validators := bondValidators(t, k, ctx, bank, "alice", "bob")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+	params := k.GetParams(ctx)
+	commit := func(ctx sdk.Context, validator string, valid bool) {
+		t.Helper()
+		if _, err := k.ClaimWork(ctx, workID, validator); err != nil {
+			t.Fatalf("%s failed to claim work: %v", validator, err)
+		}
+		commitment := types.ValidationCommitment(workID, validator, valid, 90, "proof", salt)
+		if err := k.CommitValidation(ctx, workID, validator, commitment); err != nil {
+			t.Fatalf("%s failed to commit: %v", validator, err)
+		}
+	}
+
+	// The first commitment opens the round's commit window
+	commit(ctx, validators[0], true)
+	work, _ := k.GetWork(ctx, workID)
+	if work.Status != types.WorkStatusPending || work.CommitEndsAt != ctx.BlockHeight()+params.CommitBlocks {
+		t.Fatalf("work is %s with its commit window ending at %d, want pending until %d", work.Status, work.CommitEndsAt, ctx.BlockHeight()+params.CommitBlocks)
+	}
+
+	// The round stays open to commitments until the window closes
+	open := ctx.WithBlockHeight(work.CommitEndsAt - 1)
+	if _, err := k.ClaimWork(open, workID, validators[1]); err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+	if err := k.CloseCommitWindows(open); err != nil {
+		t.Fatalf("failed to close commit windows: %v", err)
+	}
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusValidating {
+		t.Fatalf("work is %s before its commit window closed, want validating", work.Status)
+	}
+
+	// Then the reveal phase opens short of quorum and the lease is released
+	closed := ctx.WithBlockHeight(work.CommitEndsAt)
+	if err := k.CloseCommitWindows(closed); err != nil {
+		t.Fatalf("failed to close commit windows: %v", err)
+	}
+	work, _ = k.GetWork(ctx, workID)
+	if work.Status != types.WorkStatusRevealing || work.ClaimedBy != "" || work.CommitEndsAt != 0 || work.RevealEndsAt != closed.BlockHeight()+params.RevealBlocks {
+		t.Fatalf("work is %s claimed by %q with commit window %d revealing until %d, want revealing until %d",
+			work.Status, work.ClaimedBy, work.CommitEndsAt, work.RevealEndsAt, closed.BlockHeight()+params.RevealBlocks)
+	}
+
+	// Revealing the lone commitment tallies a round that missed quorum, and
+	// the next round opens a window of its own
+	if err := k.RevealValidation(closed, workID, validators[0], true, 90, "proof", salt); err != nil {
+		t.Fatalf("failed to reveal: %v", err)
+	}
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusPending || work.CommitEndsAt != 0 {
+		t.Fatalf("tallied round is %s with commit window %d, want pending with none", work.Status, work.CommitEndsAt)
+	}
+	commit(closed, validators[1], false)
+	if work, _ := k.GetWork(ctx, workID); work.CommitEndsAt != closed.BlockHeight()+params.CommitBlocks {
+		t.Fatalf("next round's commit window ends at %d, want %d", work.CommitEndsAt, closed.BlockHeight()+params.CommitBlocks)
+	}
+}
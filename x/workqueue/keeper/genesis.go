@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maco144/pickle/x/workqueue/types"
 )
@@ -12,14 +11,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		return
 	}
 
-	// Import initial work queue state
-	if genState.WorkQueue != nil {
-		// Initialize pending work
-		for _, work := range genState.WorkQueue.PendingWork {
-			workCopy := work
-			if err := k.SubmitWork(ctx, workCopy); err != nil {
-				panic(err)
-			}
+	// Import params before any work so submissions are checked against them
+	params := genState.Params
+	if params == nil {
+		params = types.DefaultParams()
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
+	// Import work types before any work so submissions can be checked
+	for _, def := range genState.WorkTypes {
+		if err := k.SetWorkTypeDefinition(ctx, def); err != nil {
+			panic(err)
 		}
 	}
 
@@ -27,28 +31,78 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, stats := range genState.Validators {
 		k.SetValidatorStats(ctx, stats)
 	}
-}
 
-// ExportGenesis exports the module's state to a genesis state
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	genState := &types.GenesisState{
-		WorkQueue:  &types.WorkQueue{},
-		Validators: []*types.ValidatorStats{},
+	// Import quorum rules
+	for _, rule := range genState.QuorumRules {
+		k.SetQuorumRule(ctx, rule)
+	}
+
+	// Import validator bonds and unbonding entries
+	for _, bond := range genState.Bonds {
+		k.SetValidatorBond(ctx, bond)
+	}
+	for _, entry := range genState.Unbonding {
+		k.SetUnbondingEntry(ctx, entry)
 	}
 
-	// Export all work units
-	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.KeyPrefixWorkUnit)
-	iterator := prefixStore.Iterator(nil, nil)
-	defer iterator.Close()
+	// Import unrevealed validation commitments
+	for _, commit := range genState.Commits {
+		k.SetValidationCommit(ctx, commit)
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var work types.WorkUnit
-		k.cdc.MustUnmarshal(iterator.Value(), &work)
-		if work.Status == types.WorkStatusPending {
-			genState.WorkQueue.PendingWork = append(genState.WorkQueue.PendingWork, &work)
+	// Import bounties
+	for _, bounty := range genState.Bounties {
+		k.SetBounty(ctx, bounty)
+	}
+
+	// Import stored work as is, rebuilding its indexes
+	for _, work := range genState.Work {
+		k.SetWork(ctx, work)
+	}
+
+	// Import votes, challenges and re-validation votes
+	for _, vote := range genState.Votes {
+		k.SetWorkVote(ctx, vote)
+	}
+	for _, challenge := range genState.Challenges {
+		k.SetChallenge(ctx, challenge)
+	}
+	for _, vote := range genState.ChallengeVotes {
+		k.SetChallengeVote(ctx, vote)
+	}
+
+	if genState.WorkQueue != nil {
+		// Import totals
+		k.SetTotalWorkSubmitted(ctx, genState.WorkQueue.TotalSubmitted)
+		k.SetTotalWorkValidated(ctx, genState.WorkQueue.TotalValidated)
+		k.SetTotalWorkRejected(ctx, genState.WorkQueue.TotalRejected)
+
+		// Submit new work, counted on top of the imported totals
+		for _, work := range genState.WorkQueue.PendingWork {
+			if err := k.SubmitWork(ctx, work); err != nil {
+				panic(err)
+			}
 		}
 	}
+}
+
+// ExportGenesis exports the module's state to a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		WorkQueue:      &types.WorkQueue{},
+		Validators:     []*types.ValidatorStats{},
+		QuorumRules:    []*types.QuorumRule{},
+		Bonds:          []*types.ValidatorBond{},
+		Unbonding:      []*types.UnbondingEntry{},
+		Bounties:       []*types.Bounty{},
+		WorkTypes:      []*types.WorkTypeDefinition{},
+		Commits:        []*types.ValidationCommit{},
+		Work:           []*types.WorkUnit{},
+		Votes:          []*types.WorkVote{},
+		Challenges:     []*types.Challenge{},
+		ChallengeVotes: []*types.WorkVote{},
+	}
 
 	// Export totals
 	genState.WorkQueue.TotalSubmitted = k.GetTotalWorkSubmitted(ctx)
@@ -61,5 +115,59 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	// Export quorum rules
+	k.IterateQuorumRules(ctx, func(rule *types.QuorumRule) bool {
+		genState.QuorumRules = append(genState.QuorumRules, rule)
+		return false
+	})
+
+	// Export validator bonds and unbonding entries
+	k.IterateValidatorBonds(ctx, func(bond *types.ValidatorBond) bool {
+		genState.Bonds = append(genState.Bonds, bond)
+		return false
+	})
+	k.IterateUnbonding(ctx, -1, func(entry *types.UnbondingEntry) bool {
+		genState.Unbonding = append(genState.Unbonding, entry)
+		return false
+	})
+
+	// Export work type definitions
+	k.IterateWorkTypeDefinitions(ctx, func(def *types.WorkTypeDefinition) bool {
+		genState.WorkTypes = append(genState.WorkTypes, def)
+		return false
+	})
+
+	// Export unrevealed validation commitments
+	k.IterateValidationCommits(ctx, func(commit *types.ValidationCommit) bool {
+		genState.Commits = append(genState.Commits, commit)
+		return false
+	})
+
+	// Export bounties
+	k.IterateBounties(ctx, func(bounty *types.Bounty) bool {
+		genState.Bounties = append(genState.Bounties, bounty)
+		return false
+	})
+
+	// Export work units in every status
+	k.IterateWork(ctx, func(work *types.WorkUnit) bool {
+		genState.Work = append(genState.Work, work)
+		return false
+	})
+
+	// Export votes, challenges and re-validation votes
+	k.IterateWorkVotes(ctx, func(vote *types.WorkVote) bool {
+		genState.Votes = append(genState.Votes, vote)
+		return false
+	})
+	k.IterateChallenges(ctx, func(challenge *types.Challenge) bool {
+		genState.Challenges = append(genState.Challenges, challenge)
+		return false
+	})
+	k.IterateChallengeVotes(ctx, func(vote *types.WorkVote) bool {
+		genState.ChallengeVotes = append(genState.ChallengeVotes, vote)
+		return false
+	})
+
 	return genState
 }
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// populateLedger writes work in every status together with the votes,
+// challenges, commitments, bonds and counters that refer to it
+func populateLedger(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	t.Helper()
+
+	k.InitGenesis(ctx, types.DefaultGenesis())
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeMLData, RequiredVotes: 3, RequiredAgreement: 2})
+
+	alice := testAddr("alice")
+	bob := testAddr("bob")
+	submitter := testAddr("submitter")
+	coin := func(amount int64) *basev1beta1.Coin {
+		return types.NewProtoCoin(sdk.NewInt64Coin(types.DefaultBondDenom, amount))
+	}
+
+	for _, validator := range []string{alice, bob} {
+		k.SetValidatorStats(ctx, &types.ValidatorStats{
+			Address:            validator,
+			TotalWorkValidated: 4,
+			TotalWorkRejected:  1,
+			Specializations:    map[string]uint64{types.WorkTypeCrypto: 3, types.WorkTypeMLData: 2},
+			AverageConfidence:  85,
+			LastActiveAt:       40,
+		})
+	}
+	k.SetValidatorBond(ctx, &types.ValidatorBond{Validator: alice, Amount: coin(10_000_000)})
+	k.SetValidatorBond(ctx, &types.ValidatorBond{Validator: bob, Amount: coin(9_500_000), SlashCount: 1, JailedUntil: 120})
+	k.SetUnbondingEntry(ctx, &types.UnbondingEntry{Validator: bob, Amount: coin(500_000), CompletionHeight: 150})
+
+	work := []*types.WorkUnit{
+		{Id: "pending", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 10, Status: types.WorkStatusPending, Submitter: submitter, ExpiresAt: 110, Priority: 3, ScheduledAt: -290},
+		{Id: "validating", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 11, Status: types.WorkStatusValidating, ClaimedBy: alice, LeaseExpiresAt: 61, ExpiresAt: 111, AssignedTo: alice, AssignedAt: 11},
+		{Id: "revealing", Type: types.WorkTypeMLData, Data: []byte(`{}`), SubmittedAt: 12, Status: types.WorkStatusRevealing, ExpiresAt: 112, RevealEndsAt: 52},
+		{Id: "validated", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 13, ValidatedAt: 20, Validator: alice, Status: types.WorkStatusValidated, Confidence: 90, Proof: "proof", Submitter: submitter},
+		{Id: "rejected", Type: types.WorkTypeSupplyChain, Data: []byte(`{}`), SubmittedAt: 14, ValidatedAt: 21, Validator: bob, Status: types.WorkStatusRejected, Confidence: 70, Proof: "malformed"},
+		{Id: "challenged", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 15, ValidatedAt: 22, Validator: alice, Status: types.WorkStatusChallenged, Confidence: 80},
+		{Id: "expired", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 16, Status: types.WorkStatusExpired, ExpiresAt: 30},
+	}
+	for _, w := range work {
+		k.SetWork(ctx, w)
+	}
+
+	k.SetWorkVote(ctx, &types.WorkVote{WorkId: "revealing", Validator: alice, Valid: true, Confidence: 90, VotedAt: 40})
+	k.SetWorkVote(ctx, &types.WorkVote{WorkId: "validated", Validator: alice, Valid: true, Confidence: 90, Proof: "proof", VotedAt: 20})
+	k.SetWorkVote(ctx, &types.WorkVote{WorkId: "rejected", Validator: bob, Confidence: 70, Proof: "malformed", VotedAt: 21})
+	k.SetWorkVote(ctx, &types.WorkVote{WorkId: "challenged", Validator: alice, Valid: true, Confidence: 80, VotedAt: 22})
+	k.SetChallenge(ctx, &types.Challenge{WorkId: "challenged", Challenger: submitter, Bond: coin(1_000_000), Reason: "wrong", CreatedAt: 25, OriginalStatus: types.WorkStatusValidated})
+	k.SetChallengeVote(ctx, &types.WorkVote{WorkId: "challenged", Validator: bob, Confidence: 60, VotedAt: 30})
+	k.SetValidationCommit(ctx, &types.ValidationCommit{WorkId: "revealing", Validator: bob, Commitment: bytes.Repeat([]byte{0xab}, 32), CommittedAt: 41})
+	k.SetBounty(ctx, &types.Bounty{WorkId: "pending", Submitter: submitter, Amount: coin(2_000), Status: types.BountyStatusEscrowed})
+	k.SetBounty(ctx, &types.Bounty{WorkId: "validated", Submitter: submitter, Amount: coin(3_000), Status: types.BountyStatusPaid, SettledAt: 20})
+
+	k.SetTotalWorkSubmitted(ctx, 7)
+	k.SetTotalWorkValidated(ctx, 2)
+	k.SetTotalWorkRejected(ctx, 1)
+}
+
+// storeContents returns every key and value in a store, in key order
+func storeContents(ctx sdk.Context, key storetypes.StoreKey) [][2][]byte {
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contents [][2][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		contents = append(contents, [2][]byte{iterator.Key(), iterator.Value()})
+	}
+	return contents
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	source, sourceCtx, sourceKey := newTestKeeper()
+	populateLedger(t, source, sourceCtx)
+
+	exported := source.ExportGenesis(sourceCtx)
+	if err := exported.Validate(); err != nil {
+		t.Fatalf("exported genesis is invalid: %v", err)
+	}
+	if len(exported.Work) != 7 || len(exported.Votes) != 4 || len(exported.ChallengeVotes) != 1 || len(exported.Validators) != 2 {
+		t.Fatalf("export is missing state: %d work, %d votes, %d challenge votes, %d validators",
+			len(exported.Work), len(exported.Votes), len(exported.ChallengeVotes), len(exported.Validators))
+	}
+	first := cdc.MustMarshalJSON(exported)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(first, &imported)
+	restored, restoredCtx, restoredKey := newTestKeeper()
+	restored.InitGenesis(restoredCtx, &imported)
+
+	second := cdc.MustMarshalJSON(restored.ExportGenesis(restoredCtx))
+	if !bytes.Equal(first, second) {
+		t.Fatalf("re-exported genesis differs\nfirst:  %s\nsecond: %s", first, second)
+	}
+
+	// Imported state, including the work indexes, matches the original store
+	want, got := storeContents(sourceCtx, sourceKey), storeContents(restoredCtx, restoredKey)
+	if len(want) != len(got) {
+		t.Fatalf("restored store has %d entries, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(want[i][0], got[i][0]) || !bytes.Equal(want[i][1], got[i][1]) {
+			t.Fatalf("restored store entry %d is %x=%x, want %x=%x", i, got[i][0], got[i][1], want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestGenesisSubmitsPendingWorkOnTopOfTotals(t *testing.T) {
+	k, ctx, _ := newTestKeeper()
+
+	genState := types.DefaultGenesis()
+	genState.WorkQueue = &types.WorkQueue{
+		PendingWork:    []*types.WorkUnit{{Type: types.WorkTypeCrypto, Data: []byte(`{}`)}},
+		TotalSubmitted: 5,
+		TotalValidated: 3,
+	}
+	k.InitGenesis(ctx, genState)
+
+	if total := k.GetTotalWorkSubmitted(ctx); total != 6 {
+		t.Fatalf("total submitted is %d, want 6", total)
+	}
+	if total := k.GetTotalWorkValidated(ctx); total != 3 {
+		t.Fatalf("total validated is %d, want 3", total)
+	}
+	if pending := k.GetPendingWork(ctx); len(pending) != 1 {
+		t.Fatalf("%d pending work units, want 1", len(pending))
+	}
+}
@@ -0,0 +1,421 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// workIndexes are the secondary indexes kept over the stored work units
+type workIndexes struct {
+	Status            *workIndex[string]
+	Type              *workIndex[string]
+	SubmittedAt       *workIndex[int64]
+	Submitter         *workIndex[string]
+	LeaseExpiry       *workIndex[int64]
+	Expiry            *workIndex[int64]
+	PendingByPriority *workIndex[int64]
+	RevealExpiry      *workIndex[int64]
+	CommitExpiry      *workIndex[int64]
+}
+
+func newWorkIndexes(sb *collections.SchemaBuilder) workIndexes {
+	return workIndexes{
+		Status: newWorkIndex(sb, types.KeyPrefixWorkByStatus, "work_by_status", collections.StringKey,
+			func(work *types.WorkUnit) (string, bool) { return work.Status, true }),
+		Type: newWorkIndex(sb, types.KeyPrefixWorkByType, "work_by_type", collections.StringKey,
+			func(work *types.WorkUnit) (string, bool) { return work.Type, true }),
+		SubmittedAt: newWorkIndex(sb, types.KeyPrefixWorkBySubmittedAt, "work_by_submitted_at", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) { return work.SubmittedAt, true }),
+		Submitter: newWorkIndex(sb, types.KeyPrefixWorkBySubmitter, "work_by_submitter", collections.StringKey,
+			func(work *types.WorkUnit) (string, bool) { return work.Submitter, work.Submitter != "" }),
+		LeaseExpiry: newWorkIndex(sb, types.KeyPrefixLeaseExpiry, "lease_expiry", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) {
+				return work.LeaseExpiresAt, work.Status == types.WorkStatusValidating
+			}),
+		Expiry: newWorkIndex(sb, types.KeyPrefixWorkExpiry, "work_expiry", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) {
+				return work.ExpiresAt, work.Status == types.WorkStatusPending
+			}),
+		PendingByPriority: newWorkIndex(sb, types.KeyPrefixPendingByPriority, "pending_by_priority", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) {
+				return work.ScheduledAt, work.Status == types.WorkStatusPending
+			}),
+		RevealExpiry: newWorkIndex(sb, types.KeyPrefixRevealExpiry, "reveal_expiry", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) {
+				return work.RevealEndsAt, work.Status == types.WorkStatusRevealing
+			}),
+		CommitExpiry: newWorkIndex(sb, types.KeyPrefixCommitExpiry, "commit_expiry", collections.Int64Key,
+			func(work *types.WorkUnit) (int64, bool) { return work.CommitEndsAt, work.CommitEndsAt > 0 }),
+	}
+}
+
+// workIndexer is implemented by every work index
+type workIndexer interface {
+	collections.Index[string, *types.WorkUnit]
+
+	// check writes a line to msg for every entry that does not match a
+	// stored work unit and every unit missing its entry, reporting whether
+	// it found any
+	check(ctx sdk.Context, k Keeper, msg *strings.Builder) (broken bool)
+}
+
+func (i workIndexes) list() []workIndexer {
+	return []workIndexer{
+		i.Status, i.Type, i.SubmittedAt, i.Submitter,
+		i.LeaseExpiry, i.Expiry, i.PendingByPriority, i.RevealExpiry,
+		i.CommitExpiry,
+	}
+}
+
+// IndexesList implements collections.Indexes
+func (i workIndexes) IndexesList() []collections.Index[string, *types.WorkUnit] {
+	var indexes []collections.Index[string, *types.WorkUnit]
+	for _, index := range i.list() {
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// workIndex indexes work units by ID under a reference key derived from each
+// unit. Units the reference function reports false for are left out, so the
+// deadline queues only hold the work in the status they serve.
+type workIndex[R comparable] struct {
+	name   string
+	refKey func(work *types.WorkUnit) (R, bool)
+	keys   collections.KeySet[collections.Pair[R, string]]
+}
+
+func newWorkIndex[R comparable](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec collcodec.KeyCodec[R],
+	refKey func(work *types.WorkUnit) (R, bool),
+) *workIndex[R] {
+	return &workIndex[R]{
+		name:   name,
+		refKey: refKey,
+		keys:   collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(refCodec, collections.StringKey)),
+	}
+}
+
+// Reference implements collections.Index
+func (i *workIndex[R]) Reference(ctx context.Context, workID string, work *types.WorkUnit, lazyOldValue func() (*types.WorkUnit, error)) error {
+	if err := i.Unreference(ctx, workID, lazyOldValue); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if ref, ok := i.refKey(work); ok {
+		return i.keys.Set(ctx, collections.Join(ref, workID))
+	}
+	return nil
+}
+
+// Unreference implements collections.Index
+func (i *workIndex[R]) Unreference(ctx context.Context, workID string, lazyOldValue func() (*types.WorkUnit, error)) error {
+	work, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	if ref, ok := i.refKey(work); ok {
+		return i.keys.Remove(ctx, collections.Join(ref, workID))
+	}
+	return nil
+}
+
+func (i *workIndex[R]) check(ctx sdk.Context, k Keeper, msg *strings.Builder) bool {
+	broken := false
+	err := i.keys.Walk(ctx, nil, func(key collections.Pair[R, string]) (bool, error) {
+		work, found := k.GetWork(ctx, key.K2())
+		if !found {
+			broken = true
+			fmt.Fprintf(msg, "\t%s entry %v for %s has no stored work unit\n", i.name, key.K1(), key.K2())
+			return false, nil
+		}
+		if ref, ok := i.refKey(work); !ok || ref != key.K1() {
+			broken = true
+			fmt.Fprintf(msg, "\t%s entry %v does not match work unit %s\n", i.name, key.K1(), key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	k.IterateWork(ctx, func(work *types.WorkUnit) bool {
+		ref, ok := i.refKey(work)
+		if !ok {
+			return false
+		}
+		indexed, err := i.keys.Has(ctx, collections.Join(ref, work.Id))
+		if err != nil {
+			panic(err)
+		}
+		if !indexed {
+			broken = true
+			fmt.Fprintf(msg, "\twork unit %s is missing its %s entry %v\n", work.Id, i.name, ref)
+		}
+		return false
+	})
+
+	return broken
+}
+
+// iterateIndex resolves the entries of an index within the optional range to
+// their work units, in key order. Iteration stops when the callback returns
+// true.
+func iterateIndex[R comparable](
+	ctx sdk.Context,
+	k Keeper,
+	index *workIndex[R],
+	ranger collections.Ranger[collections.Pair[R, string]],
+	cb func(work *types.WorkUnit) (stop bool),
+) {
+	err := index.keys.Walk(ctx, ranger, func(key collections.Pair[R, string]) (bool, error) {
+		work, found := k.GetWork(ctx, key.K2())
+		if !found {
+			return false, nil
+		}
+		return cb(work), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// heightRange ranges over the entries of a height index from start to end
+// inclusive, in descending order when reverse is set. A range resumed from an
+// entry begins at that entry instead of at its first height.
+type heightRange struct {
+	start, end int64
+	reverse    bool
+	from       *collections.Pair[int64, string]
+}
+
+// RangeValues implements collections.Ranger
+func (r heightRange) RangeValues() (start, end *collections.RangeKey[collections.Pair[int64, string]], order collections.Order, err error) {
+	start = collections.RangeKeyExact(collections.PairPrefix[int64, string](r.start))
+	end = collections.RangeKeyPrefixEnd(collections.PairPrefix[int64, string](r.end))
+	order = collections.OrderAscending
+	if r.reverse {
+		order = collections.OrderDescending
+	}
+	switch {
+	case r.from != nil && r.reverse:
+		end = collections.RangeKeyNext(*r.from)
+	case r.from != nil:
+		start = collections.RangeKeyExact(*r.from)
+	}
+	return start, end, order, nil
+}
+
+// IterateWorkByStatus iterates over all work units with the given status in
+// work ID order. Iteration stops when the callback returns true.
+func (k Keeper) IterateWorkByStatus(ctx sdk.Context, status string, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.Status, collections.NewPrefixedPairRange[string, string](status), cb)
+}
+
+// IterateWorkByType iterates over all work units of the given type in work ID
+// order. Iteration stops when the callback returns true.
+func (k Keeper) IterateWorkByType(ctx sdk.Context, workType string, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.Type, collections.NewPrefixedPairRange[string, string](workType), cb)
+}
+
+// IterateWorkBySubmitter iterates over all work units submitted by an address
+// in work ID order. Iteration stops when the callback returns true.
+func (k Keeper) IterateWorkBySubmitter(ctx sdk.Context, submitter string, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.Submitter, collections.NewPrefixedPairRange[string, string](submitter), cb)
+}
+
+// IterateWorkBySubmittedAt iterates over all work units submitted between the
+// start and end block heights (inclusive) in submission order. Iteration stops
+// when the callback returns true.
+func (k Keeper) IterateWorkBySubmittedAt(ctx sdk.Context, start, end int64, cb func(work *types.WorkUnit) (stop bool)) {
+	if end < start {
+		return
+	}
+	iterateIndex(ctx, k, k.work.Indexes.SubmittedAt, heightRange{start: start, end: end}, cb)
+}
+
+// IteratePendingByPriority iterates over all pending work units, highest
+// priority first after aging and oldest first among equals. Iteration stops
+// when the callback returns true.
+func (k Keeper) IteratePendingByPriority(ctx sdk.Context, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.PendingByPriority, nil, cb)
+}
+
+// IterateExpiredLeases iterates over all claimed work units whose lease expires
+// at or before the given block height, earliest expiry first. Iteration stops
+// when the callback returns true.
+func (k Keeper) IterateExpiredLeases(ctx sdk.Context, height int64, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.LeaseExpiry, heightRange{start: math.MinInt64, end: height}, cb)
+}
+
+// IterateExpiredWork iterates over all pending work units whose deadline falls
+// at or before the given block height, earliest deadline first. Iteration
+// stops when the callback returns true.
+func (k Keeper) IterateExpiredWork(ctx sdk.Context, height int64, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.Expiry, heightRange{start: math.MinInt64, end: height}, cb)
+}
+
+// IterateExpiredReveals iterates over all work units in their reveal phase
+// whose reveal deadline falls at or before the given block height, earliest
+// deadline first. Iteration stops when the callback returns true.
+func (k Keeper) IterateExpiredReveals(ctx sdk.Context, height int64, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.RevealExpiry, heightRange{start: math.MinInt64, end: height}, cb)
+}
+
+// IterateClosedCommitWindows iterates over all work units collecting
+// commitments whose commit window closes at or before the given block height,
+// earliest first. Iteration stops when the callback returns true.
+func (k Keeper) IterateClosedCommitWindows(ctx sdk.Context, height int64, cb func(work *types.WorkUnit) (stop bool)) {
+	iterateIndex(ctx, k, k.work.Indexes.CommitExpiry, heightRange{start: math.MinInt64, end: height}, cb)
+}
+
+// ListWork returns a page of work units matching the filter. The most
+// selective available index is paginated and any remaining filter fields are
+// applied to each unit. Pending work is always listed in priority order.
+func (k Keeper) ListWork(ctx sdk.Context, filter *types.WorkFilter, pageReq *query.PageRequest) ([]*types.WorkUnit, *query.PageResponse, error) {
+	indexes := k.work.Indexes
+	switch {
+	case filter == nil:
+	case filter.Status == types.WorkStatusPending:
+		return paginateIndex(ctx, k, indexes.PendingByPriority, filter, pageReq)
+	case filter.Submitter != "":
+		return paginateIndex(ctx, k, indexes.Submitter, filter, pageReq,
+			query.WithCollectionPaginationPairPrefix[string, string](filter.Submitter))
+	case filter.Status != "":
+		return paginateIndex(ctx, k, indexes.Status, filter, pageReq,
+			query.WithCollectionPaginationPairPrefix[string, string](filter.Status))
+	case filter.WorkType != "":
+		return paginateIndex(ctx, k, indexes.Type, filter, pageReq,
+			query.WithCollectionPaginationPairPrefix[string, string](filter.WorkType))
+	case filter.MinSubmittedAt > 0 || filter.MaxSubmittedAt > 0:
+		return paginateSubmittedAt(ctx, k, filter, pageReq)
+	}
+
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.work,
+		pageReq,
+		func(_ string, work *types.WorkUnit) (bool, error) {
+			return filter.Matches(work), nil
+		},
+		func(_ string, work *types.WorkUnit) (*types.WorkUnit, error) {
+			return work, nil
+		},
+	)
+}
+
+// paginateIndex returns a page of the work units of an index that match the
+// filter
+func paginateIndex[R comparable](
+	ctx sdk.Context,
+	k Keeper,
+	index *workIndex[R],
+	filter *types.WorkFilter,
+	pageReq *query.PageRequest,
+	opts ...func(*query.CollectionsPaginateOptions[collections.Pair[R, string]]),
+) ([]*types.WorkUnit, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx,
+		index.keys,
+		pageReq,
+		func(key collections.Pair[R, string], _ collections.NoValue) (bool, error) {
+			work, found := k.GetWork(ctx, key.K2())
+			return found && filter.Matches(work), nil
+		},
+		func(key collections.Pair[R, string], _ collections.NoValue) (*types.WorkUnit, error) {
+			work, _ := k.GetWork(ctx, key.K2())
+			return work, nil
+		},
+		opts...,
+	)
+}
+
+// paginateSubmittedAt returns a page of the work units that match the filter
+// within its submission heights. Only the entries of the submission height
+// index between the filter's bounds are walked, so a narrow window over a long
+// history stays cheap; pages follow the key, offset and total conventions of
+// the SDK's paginators.
+func paginateSubmittedAt(ctx sdk.Context, k Keeper, filter *types.WorkFilter, pageReq *query.PageRequest) ([]*types.WorkUnit, *query.PageResponse, error) {
+	keys := k.work.Indexes.SubmittedAt.keys
+
+	req := query.PageRequest{}
+	if pageReq != nil {
+		req = *pageReq
+	}
+	if len(req.Key) != 0 && req.Offset > 0 {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+	if req.Limit == 0 {
+		req.Limit = query.DefaultLimit
+		req.CountTotal = true
+	}
+
+	heights := heightRange{start: filter.MinSubmittedAt, end: filter.MaxSubmittedAt, reverse: req.Reverse}
+	if heights.end == 0 {
+		heights.end = math.MaxInt64
+	}
+	if len(req.Key) != 0 {
+		_, from, err := keys.KeyCodec().Decode(req.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid page key: %w", err)
+		}
+		if from.K1() < heights.start || from.K1() > heights.end {
+			return nil, nil, fmt.Errorf("page key at height %d is outside the filtered heights", from.K1())
+		}
+		heights.from = &from
+	}
+
+	iter, err := keys.Iterate(ctx, heights)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		works   []*types.WorkUnit
+		matched uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		work, found := k.GetWork(ctx, key.K2())
+		if !found || !filter.Matches(work) {
+			continue
+		}
+		switch {
+		case matched < req.Offset:
+		case uint64(len(works)) < req.Limit:
+			works = append(works, work)
+		case nextKey == nil:
+			if nextKey, err = collections.EncodeKeyWithPrefix(nil, keys.KeyCodec(), key); err != nil {
+				return nil, nil, err
+			}
+		}
+		matched++
+		// Keep counting only when the total was asked for
+		if nextKey != nil && (!req.CountTotal || len(req.Key) != 0) {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if req.CountTotal && len(req.Key) == 0 {
+		pageRes.Total = matched
+	}
+	return works, pageRes, nil
+}
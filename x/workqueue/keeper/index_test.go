@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestPendingWorkServedByPriority(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	validator := bondValidators(t, k, ctx, bank, "alice")[0]
+	aging := k.GetParams(ctx).PriorityAgingBlocks
+
+	// Each unit is scheduled its priority's worth of aging ahead of its
+	// submission height
+	submit := func(id string, height int64, priority uint32) {
+		t.Helper()
+		work := &types.WorkUnit{Id: id, Type: types.WorkTypeCrypto, Data: []byte(id), Submitter: testAddr("submitter"), Priority: priority}
+		if err := k.SubmitWork(ctx.WithBlockHeight(height), work); err != nil {
+			t.Fatalf("failed to submit %s: %v", id, err)
+		}
+		if want := height - int64(priority)*aging; work.ScheduledAt != want {
+			t.Fatalf("%s is scheduled at %d, want %d", id, work.ScheduledAt, want)
+		}
+	}
+	submit("old-low", 10, 0)
+	submit("new-high", 10+aging+50, 1)
+	submit("new-low", 10+aging+50, 0)
+	submit("newest-top", 10+aging+100, 2)
+	submit("tied-high", 10+aging, 1)
+
+	// Low priority work that has waited longer than a priority level's aging
+	// overtakes newer work of that level, and ties go by ID
+	want := []string{"newest-top", "old-low", "tied-high", "new-high", "new-low"}
+	if got := workIDs(func(cb func(*types.WorkUnit) bool) { k.IteratePendingByPriority(ctx, cb) }); !reflect.DeepEqual(got, want) {
+		t.Fatalf("pending work is served as %v, want %v", got, want)
+	}
+	if got := ids(k.GetPendingWork(ctx)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("pending work is listed as %v, want %v", got, want)
+	}
+
+	// Claimed work leaves the priority index
+	if _, err := k.ClaimWork(ctx, "old-low", validator); err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+	want = []string{"newest-top", "tied-high", "new-high", "new-low"}
+	if got := workIDs(func(cb func(*types.WorkUnit) bool) { k.IteratePendingByPriority(ctx, cb) }); !reflect.DeepEqual(got, want) {
+		t.Fatalf("pending work is served as %v after a claim, want %v", got, want)
+	}
+
+	maxPriority := k.GetParams(ctx).MaxPriority
+	work := &types.WorkUnit{Type: types.WorkTypeCrypto, Data: []byte("urgent"), Submitter: testAddr("submitter"), Priority: maxPriority + 1}
+	if err := k.SubmitWork(ctx, work); !errors.Is(err, types.ErrInvalidPriority) {
+		t.Fatalf("submitting above the maximum priority returned %v, want %v", err, types.ErrInvalidPriority)
+	}
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// InvariantRoute is a module invariant and the route it is registered under
+type InvariantRoute struct {
+	Route     string
+	Invariant sdk.Invariant
+}
+
+// Invariants returns the module invariants in registration order
+func (k Keeper) Invariants() []InvariantRoute {
+	return []InvariantRoute{
+		{"total-submitted", TotalSubmittedInvariant(k)},
+		{"finalized-counts", FinalizedCountsInvariant(k)},
+		{"validator-stats", ValidatorStatsInvariant(k)},
+		{"work-indexes", WorkIndexesInvariant(k)},
+	}
+}
+
+// RegisterInvariants registers the workqueue module invariants with an
+// invariant registry such as x/crisis
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, inv := range k.Invariants() {
+		ir.RegisterRoute(types.ModuleName, inv.Route, inv.Invariant)
+	}
+}
+
+// AllInvariants runs all invariants of the workqueue module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range k.Invariants() {
+			if res, stop := inv.Invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalSubmittedInvariant checks that the submitted counter equals the number
+// of stored work units
+func TotalSubmittedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var stored uint64
+		k.IterateWork(ctx, func(*types.WorkUnit) bool {
+			stored++
+			return false
+		})
+
+		total := k.GetTotalWorkSubmitted(ctx)
+		broken := total != stored
+		return sdk.FormatInvariant(types.ModuleName, "total-submitted",
+			fmt.Sprintf("\ttotal submitted: %d\n\tstored work units: %d\n", total, stored)), broken
+	}
+}
+
+// FinalizedCountsInvariant checks that the validated and rejected counters
+// equal the number of work units finalized with each status. Work under an
+// unresolved challenge counts under the status being disputed.
+func FinalizedCountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var validated, rejected uint64
+		k.IterateWork(ctx, func(work *types.WorkUnit) bool {
+			status := work.Status
+			if challenge, active := k.activeChallenge(ctx, work.Id); active {
+				status = challenge.OriginalStatus
+			}
+			switch status {
+			case types.WorkStatusValidated:
+				validated++
+			case types.WorkStatusRejected:
+				rejected++
+			}
+			return false
+		})
+
+		totalValidated := k.GetTotalWorkValidated(ctx)
+		totalRejected := k.GetTotalWorkRejected(ctx)
+		broken := totalValidated != validated || totalRejected != rejected
+		return sdk.FormatInvariant(types.ModuleName, "finalized-counts", fmt.Sprintf(
+			"\ttotal validated: %d, validated work units: %d\n\ttotal rejected: %d, rejected work units: %d\n",
+			totalValidated, validated, totalRejected, rejected)), broken
+	}
+}
+
+// ValidatorStatsInvariant checks that each validator's validated and rejected
+// totals equal the valid and invalid votes it cast, counting both original
+// and re-validation votes
+func ValidatorStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		valid := make(map[string]uint64)
+		invalid := make(map[string]uint64)
+		countVote := func(vote *types.WorkVote) bool {
+			if vote.Valid {
+				valid[vote.Validator]++
+			} else {
+				invalid[vote.Validator]++
+			}
+			return false
+		}
+		k.IterateWorkVotes(ctx, countVote)
+		k.IterateChallengeVotes(ctx, countVote)
+
+		var msg strings.Builder
+		broken := false
+		k.IterateValidators(ctx, func(validator string, stats *types.ValidatorStats) bool {
+			if stats.TotalWorkValidated != valid[validator] || stats.TotalWorkRejected != invalid[validator] {
+				broken = true
+				fmt.Fprintf(&msg, "\t%s: stats %d validated, %d rejected; votes %d valid, %d invalid\n",
+					validator, stats.TotalWorkValidated, stats.TotalWorkRejected, valid[validator], invalid[validator])
+			}
+			delete(valid, validator)
+			delete(invalid, validator)
+			return false
+		})
+
+		// Any remaining votes were cast by validators without stats
+		var unknown []string
+		for validator := range valid {
+			unknown = append(unknown, validator)
+		}
+		for validator := range invalid {
+			if _, ok := valid[validator]; !ok {
+				unknown = append(unknown, validator)
+			}
+		}
+		sort.Strings(unknown)
+		for _, validator := range unknown {
+			broken = true
+			fmt.Fprintf(&msg, "\t%s: votes cast without validator stats\n", validator)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-stats", msg.String()), broken
+	}
+}
+
+// WorkIndexesInvariant checks that every secondary index entry points to an
+// existing work unit with the indexed field values, and that every stored
+// work unit has all of its index entries
+func WorkIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+		broken := false
+		for _, index := range k.work.Indexes.list() {
+			if index.check(ctx, k, &msg) {
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "work-indexes", msg.String()), broken
+	}
+}
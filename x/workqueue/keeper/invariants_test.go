@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestInvariantsReportCorruption(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	tests := []struct {
+		route   string
+		corrupt func(k keeper.Keeper, ctx sdk.Context, key *storetypes.KVStoreKey, pendingID string)
+	}{
+		{"total-submitted", func(k keeper.Keeper, ctx sdk.Context, _ *storetypes.KVStoreKey, _ string) {
+			k.SetTotalWorkSubmitted(ctx, k.GetTotalWorkSubmitted(ctx)+1)
+		}},
+		{"finalized-counts", func(k keeper.Keeper, ctx sdk.Context, _ *storetypes.KVStoreKey, _ string) {
+			k.IncrementTotalRejected(ctx)
+		}},
+		{"validator-stats", func(k keeper.Keeper, ctx sdk.Context, _ *storetypes.KVStoreKey, _ string) {
+			stats, _ := k.GetValidatorStats(ctx, testAddr("alice"))
+			stats.TotalWorkValidated++
+			k.SetValidatorStats(ctx, stats)
+		}},
+		{"work-indexes", func(k keeper.Keeper, ctx sdk.Context, key *storetypes.KVStoreKey, pendingID string) {
+			// Rewrite the work unit under its raw key, past the indexes
+			work, _ := k.GetWork(ctx, pendingID)
+			work.SubmittedAt++
+			ctx.KVStore(key).Set(append(types.KeyPrefixWorkUnit.Bytes(), pendingID...), cdc.MustMarshal(work))
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.route, func(t *testing.T) {
+			// Build a consistent ledger through the keeper: two validators,
+			// work they validated and rejected, and pending work
+			k, ctx, key := newTestKeeper()
+			ctx = ctx.WithBlockHeight(10)
+			k.InitGenesis(ctx, types.DefaultGenesis())
+			minBond := types.NewProtoCoin(k.GetParams(ctx).MinValidatorBondCoin())
+			validators := []string{testAddr("alice"), testAddr("bob")}
+			for _, validator := range validators {
+				k.SetValidatorBond(ctx, &types.ValidatorBond{Validator: validator, Amount: minBond})
+				k.SetValidatorStats(ctx, &types.ValidatorStats{Address: validator})
+			}
+			for i, valid := range []bool{true, false, true} {
+				workID := submitWork(t, k, ctx, fmt.Sprintf(`{"block":%d}`, i))
+				castVote(t, k, ctx, workID, validators[i%2], valid)
+			}
+			pendingID := submitWork(t, k, ctx, `{"block":"pending"}`)
+
+			for _, inv := range k.Invariants() {
+				if msg, broken := inv.Invariant(ctx); broken {
+					t.Fatalf("consistent ledger broke an invariant: %s", msg)
+				}
+			}
+
+			// Only the invariant guarding the corrupted state breaks
+			tc.corrupt(k, ctx, key, pendingID)
+			for _, inv := range k.Invariants() {
+				msg, broken := inv.Invariant(ctx)
+				if broken != (inv.Route == tc.route) {
+					t.Fatalf("%s invariant reports broken %t after corrupting %s: %s", inv.Route, broken, tc.route, msg)
+				}
+			}
+			if _, broken := keeper.AllInvariants(k)(ctx); !broken {
+				t.Fatalf("all invariants pass after corrupting %s", tc.route)
+			}
+		})
+	}
+}
+
+func TestInvariantsQueryIsOptIn(t *testing.T) {
+	k, ctx, _ := newTestKeeper()
+	k.InitGenesis(ctx, types.DefaultGenesis())
+
+	// Nodes do not scan the store for the query unless they enable it
+	_, err := keeper.NewQueryServerImpl(k).CheckInvariants(ctx, &types.QueryCheckInvariantsRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("querying invariants on a default node returned %v, want %v", err, codes.Unavailable)
+	}
+
+	k.EnableInvariantsQuery()
+	res, err := keeper.NewQueryServerImpl(k).CheckInvariants(ctx, &types.QueryCheckInvariantsRequest{})
+	if err != nil {
+		t.Fatalf("failed to query invariants: %v", err)
+	}
+	if len(res.Invariants) != len(k.Invariants()) {
+		t.Fatalf("query ran %d invariants, want %d", len(res.Invariants), len(k.Invariants()))
+	}
+	for _, result := range res.Invariants {
+		if result.Broken {
+			t.Fatalf("%s invariant is broken on a fresh store: %s", result.Route, result.Message)
+		}
+	}
+}
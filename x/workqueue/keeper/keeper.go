@@ -1,13 +1,15 @@
 package keeper
 
 import (
-	"crypto/sha256"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
-	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maco144/pickle/x/workqueue/types"
@@ -15,19 +17,145 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.BinaryCodec
-		storeKey *storetypes.KVStoreKey
-		memKey   *storetypes.MemoryStoreKey
+		cdc        codec.BinaryCodec
+		storeKey   *storetypes.KVStoreKey
+		memKey     *storetypes.MemoryStoreKey
+		bankKeeper types.BankKeeper
+		hooks      types.WorkqueueHooks
+
+		// authority is the address allowed to update the module parameters
+		authority string
+
+		// invariantsQuery serves the CheckInvariants query, which scans the
+		// whole store
+		invariantsQuery bool
+
+		schema             collections.Schema
+		params             collections.Item[*types.Params]
+		work               *collections.IndexedMap[string, *types.WorkUnit, workIndexes]
+		validatorStats     collections.Map[string, *types.ValidatorStats]
+		workTypes          collections.Map[string, *types.WorkTypeDefinition]
+		quorumRules        collections.Map[string, *types.QuorumRule]
+		votes              collections.Map[collections.Pair[string, string], *types.WorkVote]
+		challenges         collections.Map[string, *types.Challenge]
+		openChallenges     collections.KeySet[collections.Pair[int64, string]]
+		challengeVotes     collections.Map[collections.Pair[string, string], *types.WorkVote]
+		validationCommits  collections.Map[collections.Pair[string, string], *types.ValidationCommit]
+		bonds              collections.Map[string, *types.ValidatorBond]
+		unbonding          collections.Map[collections.Pair[int64, string], *types.UnbondingEntry]
+		validatorUnbonding collections.KeySet[collections.Pair[string, int64]]
+		bounties           collections.Map[string, *types.Bounty]
+		bountySettlements  collections.KeySet[collections.Pair[int64, string]]
+		totalSubmitted     collections.Item[uint64]
+		totalValidated     collections.Item[uint64]
+		totalRejected      collections.Item[uint64]
 	}
 )
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, storeKey *storetypes.KVStoreKey, memKey *storetypes.MemoryStoreKey) Keeper {
-	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		memKey:   memKey,
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey *storetypes.KVStoreKey,
+	memKey *storetypes.MemoryStoreKey,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+
+	k := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		memKey:     memKey,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+
+		params: collections.NewItem(sb, types.KeyParams, "params", newProtoValue[types.Params](cdc)),
+		work: collections.NewIndexedMap(
+			sb, types.KeyPrefixWorkUnit, "work",
+			collections.StringKey, newProtoValue[types.WorkUnit](cdc),
+			newWorkIndexes(sb),
+		),
+		validatorStats: collections.NewMap(
+			sb, types.KeyPrefixValidatorStats, "validator_stats",
+			collections.StringKey, newProtoValue[types.ValidatorStats](cdc),
+		),
+		workTypes: collections.NewMap(
+			sb, types.KeyPrefixWorkType, "work_types",
+			collections.StringKey, newProtoValue[types.WorkTypeDefinition](cdc),
+		),
+		quorumRules: collections.NewMap(
+			sb, types.KeyPrefixQuorumRule, "quorum_rules",
+			collections.StringKey, newProtoValue[types.QuorumRule](cdc),
+		),
+		votes: collections.NewMap(
+			sb, types.KeyPrefixWorkVote, "votes",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), newProtoValue[types.WorkVote](cdc),
+		),
+		challenges: collections.NewMap(
+			sb, types.KeyPrefixChallenge, "challenges",
+			collections.StringKey, newProtoValue[types.Challenge](cdc),
+		),
+		openChallenges: collections.NewKeySet(
+			sb, types.KeyPrefixChallengeByCreatedAt, "open_challenges",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		challengeVotes: collections.NewMap(
+			sb, types.KeyPrefixChallengeVote, "challenge_votes",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), newProtoValue[types.WorkVote](cdc),
+		),
+		validationCommits: collections.NewMap(
+			sb, types.KeyPrefixValidationCommit, "validation_commits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), newProtoValue[types.ValidationCommit](cdc),
+		),
+		bonds: collections.NewMap(
+			sb, types.KeyPrefixValidatorBond, "bonds",
+			collections.StringKey, newProtoValue[types.ValidatorBond](cdc),
+		),
+		unbonding: collections.NewMap(
+			sb, types.KeyPrefixUnbonding, "unbonding",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey), newProtoValue[types.UnbondingEntry](cdc),
+		),
+		validatorUnbonding: collections.NewKeySet(
+			sb, types.KeyPrefixUnbondingByValidator, "unbonding_by_validator",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+		),
+		bounties: collections.NewMap(
+			sb, types.KeyPrefixBounty, "bounties",
+			collections.StringKey, newProtoValue[types.Bounty](cdc),
+		),
+		bountySettlements: collections.NewKeySet(
+			sb, types.KeyPrefixBountySettlement, "bounty_settlements",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		totalSubmitted: collections.NewItem(sb, types.KeyTotalSubmitted, "total_submitted", collections.Uint64Value),
+		totalValidated: collections.NewItem(sb, types.KeyTotalValidated, "total_validated", collections.Uint64Value),
+		totalRejected:  collections.NewItem(sb, types.KeyTotalRejected, "total_rejected", collections.Uint64Value),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	return k
+}
+
+// SetHooks sets the hooks called when work units leave the queue. It panics
+// if hooks were already set.
+func (k *Keeper) SetHooks(hooks types.WorkqueueHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set workqueue hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// EnableInvariantsQuery serves the CheckInvariants query. Running the
+// invariants scans the whole store, so it is off unless the node opts in.
+func (k *Keeper) EnableInvariantsQuery() *Keeper {
+	k.invariantsQuery = true
+	return k
 }
 
 // Logger returns a module-specific logger
@@ -37,14 +165,55 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // SubmitWork submits a new work unit for validation
 func (k Keeper) SubmitWork(ctx sdk.Context, workUnit *types.WorkUnit) error {
-	// Generate ID if not provided (use block height + hash)
+	// Work types are part of the type index key
+	if err := types.ValidateKeyComponent(workUnit.Type, types.MaxIndexedFieldLength); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidWorkType, "work type %s", err)
+	}
+
+	// Submitters are part of the submitter index key
+	if err := types.ValidateKeyComponent(workUnit.Submitter, types.MaxIndexedFieldLength); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSubmitter, "submitter %s", err)
+	}
+
+	// Generate a content hash ID if not provided
 	if workUnit.Id == "" {
-		hash := sha256.Sum256(workUnit.Data)
-		workUnit.Id = fmt.Sprintf("%d-%x", ctx.BlockHeight(), hash[:8])
+		workUnit.Id = types.WorkID(workUnit.Type, workUnit.Data)
+	} else if err := types.ValidateClientWorkID(workUnit.Id, workUnit.Type, workUnit.Data); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidWorkID, err.Error())
+	}
+
+	// Work IDs lead the vote and commitment keys
+	if err := types.ValidateKeyComponent(workUnit.Id, types.MaxWorkIDLength); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidWorkID, "work id %s", err)
+	}
+
+	params := k.GetParams(ctx)
+	def, found := k.GetWorkTypeDefinition(ctx, workUnit.Type)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownWorkType, "work type %s is not registered", workUnit.Type)
+	}
+	if !def.Enabled {
+		return errorsmod.Wrapf(types.ErrWorkTypeDisabled, "work type %s does not accept new work", workUnit.Type)
+	}
+	if maxSize := def.MaxDataSizeWithin(params.MaxDataSize); maxSize > 0 && uint64(len(workUnit.Data)) > maxSize {
+		return errorsmod.Wrapf(types.ErrWorkTooLarge, "%d bytes exceeds the maximum of %d", len(workUnit.Data), maxSize)
+	}
+	if err := def.ValidateData(workUnit.Data); err != nil {
+		return errorsmod.Wrap(types.ErrSchemaViolation, err.Error())
+	}
+	if workUnit.Priority > params.MaxPriority {
+		return errorsmod.Wrapf(types.ErrInvalidPriority, "priority %d exceeds the maximum of %d", workUnit.Priority, params.MaxPriority)
+	}
+
+	// Never overwrite an existing record; finalized work is immutable
+	if existing, found := k.GetWork(ctx, workUnit.Id); found {
+		return errorsmod.Wrapf(types.ErrDuplicateWorkID, "%s (status: %s)", workUnit.Id, existing.Status)
 	}
 
 	// Set submission block height
 	workUnit.SubmittedAt = ctx.BlockHeight()
+	workUnit.ExpiresAt = workUnit.SubmittedAt + params.WorkExpiryBlocks
+	workUnit.ScheduledAt = params.ScheduledAt(workUnit.SubmittedAt, workUnit.Priority)
 	workUnit.Status = types.WorkStatusPending
 
 	// Store the work unit
@@ -54,115 +223,226 @@ func (k Keeper) SubmitWork(ctx sdk.Context, workUnit *types.WorkUnit) error {
 	k.IncrementTotalSubmitted(ctx)
 
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWorkSubmitted,
-			sdk.NewAttribute(types.AttributeKeyWorkID, workUnit.Id),
-			sdk.NewAttribute(types.AttributeKeyWorkType, workUnit.Type),
-			sdk.NewAttribute(types.AttributeKeySubmittedAt, fmt.Sprintf("%d", workUnit.SubmittedAt)),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkSubmitted{
+		WorkId:      workUnit.Id,
+		WorkType:    workUnit.Type,
+		Submitter:   workUnit.Submitter,
+		SubmittedAt: workUnit.SubmittedAt,
+		Priority:    workUnit.Priority,
+		ExpiresAt:   workUnit.ExpiresAt,
+	}); err != nil {
+		return err
+	}
 
+	if k.hooks != nil {
+		return k.hooks.AfterWorkSubmitted(ctx, workUnit)
+	}
 	return nil
 }
 
 // GetWork retrieves a work unit by ID
 func (k Keeper) GetWork(ctx sdk.Context, workID string) (*types.WorkUnit, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.WorkUnitKey(workID))
-	if bz == nil {
-		return nil, false
-	}
-
-	var work types.WorkUnit
-	k.cdc.MustUnmarshal(bz, &work)
-	return &work, true
+	return get(ctx, k.work, workID)
 }
 
-// SetWork stores a work unit
+// SetWork stores a work unit, replacing the secondary index entries of the
+// previous version with those of the new one
 func (k Keeper) SetWork(ctx sdk.Context, work *types.WorkUnit) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(work)
-	store.Set(types.WorkUnitKey(work.Id), bz)
+	must(k.work.Set(ctx, work.Id, work))
 }
 
-// GetValidatorStats retrieves statistics for a validator
-func (k Keeper) GetValidatorStats(ctx sdk.Context, validatorAddr string) (*types.ValidatorStats, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorStatsKey(validatorAddr))
-	if bz == nil {
-		return nil, false
+// IterateWork iterates over all stored work units in every status, ordered
+// by ID
+func (k Keeper) IterateWork(ctx sdk.Context, cb func(work *types.WorkUnit) (stop bool)) {
+	err := k.work.Walk(ctx, nil, func(_ string, work *types.WorkUnit) (bool, error) {
+		return cb(work), nil
+	})
+	if err != nil {
+		panic(err)
 	}
+}
 
-	var stats types.ValidatorStats
-	k.cdc.MustUnmarshal(bz, &stats)
-	return &stats, true
+// GetValidatorStats retrieves statistics for a validator
+func (k Keeper) GetValidatorStats(ctx sdk.Context, validatorAddr string) (*types.ValidatorStats, bool) {
+	return get(ctx, k.validatorStats, validatorAddr)
 }
 
 // SetValidatorStats stores validator statistics
 func (k Keeper) SetValidatorStats(ctx sdk.Context, stats *types.ValidatorStats) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(stats)
-	store.Set(types.ValidatorStatsKey(stats.Address), bz)
+	must(k.validatorStats.Set(ctx, stats.Address, stats))
 }
 
-// GetPendingWork returns a list of pending work units
+// GetPendingWork returns the pending work units in priority order
 func (k Keeper) GetPendingWork(ctx sdk.Context) []*types.WorkUnit {
 	var pending []*types.WorkUnit
-	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.KeyPrefixWorkUnit)
-	iterator := prefixStore.Iterator(nil, nil)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var work types.WorkUnit
-		k.cdc.MustUnmarshal(iterator.Value(), &work)
-		if work.Status == types.WorkStatusPending {
-			pending = append(pending, &work)
+	k.IteratePendingByPriority(ctx, func(work *types.WorkUnit) bool {
+		pending = append(pending, work)
+		return false
+	})
+
+	return pending
+}
+
+// IsRegisteredValidator reports whether an address is a registered validator.
+// Validators are registered by having a stats record, seeded at genesis or
+// created when the address first bonds.
+func (k Keeper) IsRegisteredValidator(ctx sdk.Context, validatorAddr string) bool {
+	registered, err := k.validatorStats.Has(ctx, validatorAddr)
+	if err != nil {
+		panic(err)
+	}
+	return registered
+}
+
+// ClaimWork leases a pending work unit to a validator, moving it to the
+// validating status until a result is submitted or the lease expires
+func (k Keeper) ClaimWork(ctx sdk.Context, workID string, validatorAddr string) (int64, error) {
+	if err := k.checkValidator(ctx, validatorAddr); err != nil {
+		return 0, err
+	}
+
+	work, found := k.GetWork(ctx, workID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrWorkNotFound, workID)
+	}
+
+	if work.Status != types.WorkStatusPending && work.Status != types.WorkStatusChallenged {
+		return 0, errorsmod.Wrapf(types.ErrWorkNotPending, "%s (status: %s)", workID, work.Status)
+	}
+
+	// Each validator votes at most once, so it cannot claim the work again.
+	// Re-validation of challenged work excludes the original voters too.
+	if _, voted := k.GetWorkVote(ctx, workID, validatorAddr); voted {
+		return 0, errorsmod.Wrapf(types.ErrAlreadyVoted, "%s on %s", validatorAddr, workID)
+	}
+	if _, voted := k.GetChallengeVote(ctx, workID, validatorAddr); voted {
+		return 0, errorsmod.Wrapf(types.ErrAlreadyVoted, "%s on %s", validatorAddr, workID)
+	}
+	if _, committed := k.GetValidationCommit(ctx, workID, validatorAddr); committed {
+		return 0, errorsmod.Wrapf(types.ErrAlreadyVoted, "%s committed on %s", validatorAddr, workID)
+	}
+
+	k.leaseWork(ctx, work, validatorAddr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkClaimed{
+		WorkId:         workID,
+		Validator:      validatorAddr,
+		LeaseExpiresAt: work.LeaseExpiresAt,
+	}); err != nil {
+		return 0, err
+	}
+
+	return work.LeaseExpiresAt, nil
+}
+
+// leaseWork moves a queued work unit to the validating status, leased to the
+// validator for the lease period
+func (k Keeper) leaseWork(ctx sdk.Context, work *types.WorkUnit, validatorAddr string) {
+	work.Status = types.WorkStatusValidating
+	work.ClaimedBy = validatorAddr
+	work.LeaseExpiresAt = ctx.BlockHeight() + k.GetParams(ctx).LeaseBlocks
+
+	k.SetWork(ctx, work)
+}
+
+// ExpireLeases returns every claimed work unit whose lease has run out by the
+// current block height to the queue it was claimed from
+func (k Keeper) ExpireLeases(ctx sdk.Context) error {
+	var expired []*types.WorkUnit
+	k.IterateExpiredLeases(ctx, ctx.BlockHeight(), func(work *types.WorkUnit) bool {
+		expired = append(expired, work)
+		return false
+	})
+
+	for _, work := range expired {
+		validatorAddr := work.ClaimedBy
+
+		work.Status = k.queuedStatus(ctx, work.Id)
+		work.ClaimedBy = ""
+		work.LeaseExpiresAt = 0
+		k.SetWork(ctx, work)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkLeaseExpired{
+			WorkId:    work.Id,
+			Validator: validatorAddr,
+		}); err != nil {
+			return err
 		}
 	}
 
-	return pending
+	return nil
+}
+
+// checkLease ensures the validator holds the current, unexpired lease on a
+// work unit
+func (k Keeper) checkLease(ctx sdk.Context, work *types.WorkUnit, validatorAddr string) error {
+	if work.Status != types.WorkStatusValidating {
+		return errorsmod.Wrapf(types.ErrWorkNotClaimed, "%s must be claimed before submitting a result (status: %s)", work.Id, work.Status)
+	}
+
+	if work.ClaimedBy != validatorAddr {
+		return errorsmod.Wrapf(types.ErrNotLeaseHolder, "%s is leased to %s", work.Id, work.ClaimedBy)
+	}
+
+	if ctx.BlockHeight() > work.LeaseExpiresAt {
+		return errorsmod.Wrapf(types.ErrLeaseExpired, "%s lease expired at height %d", work.Id, work.LeaseExpiresAt)
+	}
+
+	return nil
 }
 
-// ValidateWork marks a work unit as validated
+// ValidateWork records a validator's verdict on a claimed work unit and
+// finalizes the work once its quorum is reached. Verdicts must go through
+// CommitValidation and RevealValidation instead while commit-reveal
+// validation is enabled.
 func (k Keeper) ValidateWork(ctx sdk.Context, workID string, validatorAddr string, valid bool, confidence uint32, proof string) error {
+	if k.GetParams(ctx).CommitRevealEnabled {
+		return errorsmod.Wrap(types.ErrCommitRevealRequired, "commit the verdict with MsgCommitValidation")
+	}
+
+	if err := k.checkValidator(ctx, validatorAddr); err != nil {
+		return err
+	}
+
 	// Get the work unit
 	work, found := k.GetWork(ctx, workID)
 	if !found {
-		return fmt.Errorf("work unit not found: %s", workID)
+		return errorsmod.Wrap(types.ErrWorkNotFound, workID)
+	}
+
+	// Only the lease holder may submit a result
+	if err := k.checkLease(ctx, work, validatorAddr); err != nil {
+		return err
 	}
 
 	// Validate confidence
 	if confidence > 100 {
-		return fmt.Errorf("confidence cannot exceed 100")
+		return errorsmod.Wrap(types.ErrInvalidConfidence, "confidence cannot exceed 100")
 	}
 
-	// Update work unit
-	work.Validator = validatorAddr
-	work.ValidatedAt = ctx.BlockHeight()
-	work.Confidence = confidence
-	work.Proof = proof
-
-	if valid {
-		work.Status = types.WorkStatusValidated
-		k.IncrementTotalValidated(ctx)
-	} else {
-		work.Status = types.WorkStatusRejected
-		k.IncrementTotalRejected(ctx)
+	if err := k.castVerdict(ctx, work, validatorAddr, valid, confidence, proof); err != nil {
+		return err
 	}
 
-	// Store updated work unit
-	k.SetWork(ctx, work)
+	// Finalize the work once quorum is reached, otherwise requeue it
+	return k.tallyVotes(ctx, work)
+}
+
+// castVerdict records a validator's verdict as a vote in the work unit's
+// current round and updates the validator's stats
+func (k Keeper) castVerdict(ctx sdk.Context, work *types.WorkUnit, validatorAddr string, valid bool, confidence uint32, proof string) error {
+	// Record the validator's vote
+	k.recordVote(ctx, &types.WorkVote{
+		WorkId:     work.Id,
+		Validator:  validatorAddr,
+		Valid:      valid,
+		Confidence: confidence,
+		Proof:      proof,
+		VotedAt:    ctx.BlockHeight(),
+	})
 
 	// Update validator stats
 	stats, _ := k.GetValidatorStats(ctx, validatorAddr)
-	if stats == nil {
-		stats = &types.ValidatorStats{
-			Address:         validatorAddr,
-			Specializations: make(map[string]uint64),
-		}
-	}
 
 	if valid {
 		stats.TotalWorkValidated++
@@ -187,48 +467,48 @@ func (k Keeper) ValidateWork(ctx sdk.Context, workID string, validatorAddr strin
 	k.SetValidatorStats(ctx, stats)
 
 	// Emit event
-	statusStr := "validated"
-	if !valid {
-		statusStr = "rejected"
-	}
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWorkValidated,
-			sdk.NewAttribute(types.AttributeKeyWorkID, workID),
-			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
-			sdk.NewAttribute(types.AttributeKeyStatus, statusStr),
-			sdk.NewAttribute(types.AttributeKeyConfidence, fmt.Sprintf("%d", confidence)),
-		),
-	)
-
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventWorkValidated{
+		WorkId:     work.Id,
+		Validator:  validatorAddr,
+		Valid:      valid,
+		Confidence: confidence,
+	})
 }
 
-// RejectWork marks a work unit as rejected
+// RejectWork records a validator's rejection of a claimed work unit and
+// finalizes the work once its quorum is reached. Like ValidateWork it is
+// unavailable while commit-reveal validation is enabled.
 func (k Keeper) RejectWork(ctx sdk.Context, workID string, validatorAddr string, reason string) error {
+	if k.GetParams(ctx).CommitRevealEnabled {
+		return errorsmod.Wrap(types.ErrCommitRevealRequired, "commit the verdict with MsgCommitValidation")
+	}
+
+	if err := k.checkValidator(ctx, validatorAddr); err != nil {
+		return err
+	}
+
 	// Get the work unit
 	work, found := k.GetWork(ctx, workID)
 	if !found {
-		return fmt.Errorf("work unit not found: %s", workID)
+		return errorsmod.Wrap(types.ErrWorkNotFound, workID)
 	}
 
-	// Update work unit
-	work.Validator = validatorAddr
-	work.ValidatedAt = ctx.BlockHeight()
-	work.Status = types.WorkStatusRejected
-	work.Proof = reason
+	// Only the lease holder may submit a result
+	if err := k.checkLease(ctx, work, validatorAddr); err != nil {
+		return err
+	}
 
-	// Store updated work unit
-	k.SetWork(ctx, work)
+	// Record the validator's vote
+	k.recordVote(ctx, &types.WorkVote{
+		WorkId:    workID,
+		Validator: validatorAddr,
+		Valid:     false,
+		Proof:     reason,
+		VotedAt:   ctx.BlockHeight(),
+	})
 
 	// Update validator stats
 	stats, _ := k.GetValidatorStats(ctx, validatorAddr)
-	if stats == nil {
-		stats = &types.ValidatorStats{
-			Address:         validatorAddr,
-			Specializations: make(map[string]uint64),
-		}
-	}
 
 	stats.TotalWorkRejected++
 	stats.IncrementWorkType(work.Type)
@@ -237,85 +517,93 @@ func (k Keeper) RejectWork(ctx sdk.Context, workID string, validatorAddr string,
 	// Store updated stats
 	k.SetValidatorStats(ctx, stats)
 
-	// Increment total rejected
-	k.IncrementTotalRejected(ctx)
-
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWorkRejected,
-			sdk.NewAttribute(types.AttributeKeyWorkID, workID),
-			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
-			sdk.NewAttribute(types.AttributeKeyReason, reason),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkRejected{
+		WorkId:    workID,
+		Validator: validatorAddr,
+		Reason:    reason,
+	}); err != nil {
+		return err
+	}
 
-	return nil
+	// Finalize the work once quorum is reached, otherwise requeue it
+	return k.tallyVotes(ctx, work)
 }
 
 // GetTotalWorkValidated returns the total number of validated work units
 func (k Keeper) GetTotalWorkValidated(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte("total_validated"))
-	if bz == nil {
-		return 0
-	}
-	return sdk.BigEndianToUint64(bz)
+	return k.getCounter(ctx, k.totalValidated)
 }
 
 // GetTotalWorkRejected returns the total number of rejected work units
 func (k Keeper) GetTotalWorkRejected(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte("total_rejected"))
-	if bz == nil {
-		return 0
-	}
-	return sdk.BigEndianToUint64(bz)
+	return k.getCounter(ctx, k.totalRejected)
 }
 
 // GetTotalWorkSubmitted returns the total number of submitted work units
 func (k Keeper) GetTotalWorkSubmitted(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte("total_submitted"))
-	if bz == nil {
-		return 0
-	}
-	return sdk.BigEndianToUint64(bz)
+	return k.getCounter(ctx, k.totalSubmitted)
 }
 
 // IncrementTotalValidated increments the total validated count
 func (k Keeper) IncrementTotalValidated(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	total := k.GetTotalWorkValidated(ctx) + 1
-	store.Set([]byte("total_validated"), sdk.Uint64ToBigEndian(total))
+	must(k.totalValidated.Set(ctx, k.GetTotalWorkValidated(ctx)+1))
 }
 
 // IncrementTotalRejected increments the total rejected count
 func (k Keeper) IncrementTotalRejected(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	total := k.GetTotalWorkRejected(ctx) + 1
-	store.Set([]byte("total_rejected"), sdk.Uint64ToBigEndian(total))
+	must(k.totalRejected.Set(ctx, k.GetTotalWorkRejected(ctx)+1))
 }
 
 // IncrementTotalSubmitted increments the total submitted count
 func (k Keeper) IncrementTotalSubmitted(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	total := k.GetTotalWorkSubmitted(ctx) + 1
-	store.Set([]byte("total_submitted"), sdk.Uint64ToBigEndian(total))
+	must(k.totalSubmitted.Set(ctx, k.GetTotalWorkSubmitted(ctx)+1))
 }
 
-// IterateValidators iterates over all validators with stats
-func (k Keeper) IterateValidators(ctx sdk.Context, cb func(validator string, stats *types.ValidatorStats) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorStats)
-	iterator := prefixStore.Iterator(nil, nil)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var stats types.ValidatorStats
-		k.cdc.MustUnmarshal(iterator.Value(), &stats)
-		if !cb(stats.Address, &stats) {
-			break
-		}
+// SetTotalWorkSubmitted sets the total submitted count
+func (k Keeper) SetTotalWorkSubmitted(ctx sdk.Context, total uint64) {
+	must(k.totalSubmitted.Set(ctx, total))
+}
+
+// SetTotalWorkValidated sets the total validated count
+func (k Keeper) SetTotalWorkValidated(ctx sdk.Context, total uint64) {
+	must(k.totalValidated.Set(ctx, total))
+}
+
+// SetTotalWorkRejected sets the total rejected count
+func (k Keeper) SetTotalWorkRejected(ctx sdk.Context, total uint64) {
+	must(k.totalRejected.Set(ctx, total))
+}
+
+// DecrementTotalValidated decrements the total validated count
+func (k Keeper) DecrementTotalValidated(ctx sdk.Context) {
+	if total := k.GetTotalWorkValidated(ctx); total > 0 {
+		must(k.totalValidated.Set(ctx, total-1))
+	}
+}
+
+// DecrementTotalRejected decrements the total rejected count
+func (k Keeper) DecrementTotalRejected(ctx sdk.Context) {
+	if total := k.GetTotalWorkRejected(ctx); total > 0 {
+		must(k.totalRejected.Set(ctx, total-1))
 	}
 }
+
+// getCounter returns the value of a counter, zero until it is first set
+func (k Keeper) getCounter(ctx sdk.Context, counter collections.Item[uint64]) uint64 {
+	total, err := counter.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	if err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// IterateValidators iterates over all validators with stats
+func (k Keeper) IterateValidators(ctx sdk.Context, cb func(validator string, stats *types.ValidatorStats) (stop bool)) {
+	walk(ctx, k.validatorStats, nil, func(stats *types.ValidatorStats) bool {
+		return cb(stats.Address, stats)
+	})
+}
@@ -0,0 +1,428 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func newTestKeeper() (keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, key, nil, nil, "authority")
+	return k, ctx, key
+}
+
+// mockBank is an in-memory bank keeper holding the balances of accounts by
+// address and of modules by name, and counting the coins burned
+type mockBank struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func (b *mockBank) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (b *mockBank) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (b *mockBank) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	if err := b.send(moduleName, "", amt); err != nil {
+		return err
+	}
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+func (b *mockBank) send(from, to string, amt sdk.Coins) error {
+	balance, negative := b.balances[from].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s has %s, needs %s", from, b.balances[from], amt)
+	}
+	b.balances[from] = balance
+	if to != "" {
+		b.balances[to] = b.balances[to].Add(amt...)
+	}
+	return nil
+}
+
+// fund credits an account with an amount of the bond denom
+func (b *mockBank) fund(addr string, amount int64) {
+	b.balances[addr] = b.balances[addr].Add(sdk.NewInt64Coin(types.DefaultBondDenom, amount))
+}
+
+// balance returns the bond denom held by an account or module
+func (b *mockBank) balance(addr string) int64 {
+	return b.balances[addr].AmountOf(types.DefaultBondDenom).Int64()
+}
+
+// newBankedKeeper returns a keeper initialized from the default genesis with
+// a mock bank, and a context at height 10
+func newBankedKeeper() (keeper.Keeper, sdk.Context, *mockBank) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	k := keeper.NewKeeper(cdc, key, nil, bank, "authority")
+	k.InitGenesis(ctx, types.DefaultGenesis())
+	return k, ctx, bank
+}
+
+// updateParams applies an update to the module params
+func updateParams(t *testing.T, k keeper.Keeper, ctx sdk.Context, update func(params *types.Params)) {
+	t.Helper()
+
+	params := k.GetParams(ctx)
+	update(params)
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatalf("failed to set params: %v", err)
+	}
+}
+
+// testAddr returns the address of a named test account
+func testAddr(name string) string {
+	return sdk.AccAddress(name + strings.Repeat("_", 20-len(name))).String()
+}
+
+// bondValidators funds and bonds the minimum validator bond for each named
+// validator, returning their addresses
+func bondValidators(t *testing.T, k keeper.Keeper, ctx sdk.Context, bank *mockBank, names ...string) []string {
+	t.Helper()
+
+	var validators []string
+	for _, name := range names {
+		validator := testAddr(name)
+		bank.fund(validator, types.DefaultMinValidatorBond)
+		if err := k.Bond(ctx, validator, sdk.NewInt64Coin(types.DefaultBondDenom, types.DefaultMinValidatorBond)); err != nil {
+			t.Fatalf("failed to bond %s: %v", name, err)
+		}
+		validators = append(validators, validator)
+	}
+	return validators
+}
+
+// submitWork submits crypto work with the given data, returning its ID
+func submitWork(t *testing.T, k keeper.Keeper, ctx sdk.Context, data string) string {
+	t.Helper()
+
+	work := &types.WorkUnit{Type: types.WorkTypeCrypto, Data: []byte(data), Submitter: testAddr("submitter")}
+	if err := k.SubmitWork(ctx, work); err != nil {
+		t.Fatalf("failed to submit work: %v", err)
+	}
+	return work.Id
+}
+
+// castVote claims a work unit for a validator and votes on it
+func castVote(t *testing.T, k keeper.Keeper, ctx sdk.Context, workID, validator string, valid bool) {
+	t.Helper()
+
+	if _, err := k.ClaimWork(ctx, workID, validator); err != nil {
+		t.Fatalf("%s failed to claim %s: %v", validator, workID, err)
+	}
+	if err := k.ValidateWork(ctx, workID, validator, valid, 90, "proof"); err != nil {
+		t.Fatalf("%s failed to vote on %s: %v", validator, workID, err)
+	}
+}
+
+// bonded returns the stake a validator has bonded
+func bonded(t *testing.T, k keeper.Keeper, ctx sdk.Context, validator string) int64 {
+	t.Helper()
+
+	bond, found := k.GetValidatorBond(ctx, validator)
+	if !found {
+		t.Fatalf("%s has no bond", validator)
+	}
+	amount, err := types.SDKCoin(bond.Amount)
+	if err != nil {
+		t.Fatalf("%s has an invalid bond: %v", validator, err)
+	}
+	return amount.Amount.Int64()
+}
+
+// workIDs returns the IDs of the work units an iteration visits, in order
+func workIDs(iterate func(cb func(work *types.WorkUnit) bool)) []string {
+	var ids []string
+	iterate(func(work *types.WorkUnit) bool {
+		ids = append(ids, work.Id)
+		return false
+	})
+	return ids
+}
+
+func TestSetWorkMovesIndexEntries(t *testing.T) {
+	k, ctx, _ := newTestKeeper()
+	expect := func(index string, iterate func(cb func(*types.WorkUnit) bool), want ...string) {
+		t.Helper()
+		if got := workIDs(iterate); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s index holds %v, want %v", index, got, want)
+		}
+	}
+	byStatus := func(status string) func(cb func(*types.WorkUnit) bool) {
+		return func(cb func(*types.WorkUnit) bool) { k.IterateWorkByStatus(ctx, status, cb) }
+	}
+	byType := func(workType string) func(cb func(*types.WorkUnit) bool) {
+		return func(cb func(*types.WorkUnit) bool) { k.IterateWorkByType(ctx, workType, cb) }
+	}
+	bySubmittedAt := func(start, end int64) func(cb func(*types.WorkUnit) bool) {
+		return func(cb func(*types.WorkUnit) bool) { k.IterateWorkBySubmittedAt(ctx, start, end, cb) }
+	}
+
+	k.SetWork(ctx, &types.WorkUnit{Id: "a", Type: types.WorkTypeCrypto, Status: types.WorkStatusPending, SubmittedAt: 10})
+	k.SetWork(ctx, &types.WorkUnit{Id: "b", Type: types.WorkTypeCrypto, Status: types.WorkStatusPending, SubmittedAt: 11})
+	k.SetWork(ctx, &types.WorkUnit{Id: "c", Type: types.WorkTypeMLData, Status: types.WorkStatusPending, SubmittedAt: 12})
+	expect("pending", byStatus(types.WorkStatusPending), "a", "b", "c")
+	expect(types.WorkTypeCrypto, byType(types.WorkTypeCrypto), "a", "b")
+	expect(types.WorkTypeMLData, byType(types.WorkTypeMLData), "c")
+	expect("submitted at 10 to 11", bySubmittedAt(10, 11), "a", "b")
+
+	// Rewriting a unit moves its entries and deletes those of the previous
+	// version, which would otherwise still resolve to the unit
+	k.SetWork(ctx, &types.WorkUnit{Id: "b", Type: types.WorkTypeMLData, Status: types.WorkStatusValidated, SubmittedAt: 20})
+	expect("pending", byStatus(types.WorkStatusPending), "a", "c")
+	expect("validated", byStatus(types.WorkStatusValidated), "b")
+	expect(types.WorkTypeCrypto, byType(types.WorkTypeCrypto), "a")
+	expect(types.WorkTypeMLData, byType(types.WorkTypeMLData), "b", "c")
+	expect("submitted at 10 to 19", bySubmittedAt(10, 19), "a", "c")
+	expect("submitted at 20", bySubmittedAt(20, 20), "b")
+
+	// Rewriting a unit unchanged keeps a single entry per index
+	k.SetWork(ctx, &types.WorkUnit{Id: "b", Type: types.WorkTypeMLData, Status: types.WorkStatusValidated, SubmittedAt: 20})
+	expect("validated", byStatus(types.WorkStatusValidated), "b")
+	expect(types.WorkTypeMLData, byType(types.WorkTypeMLData), "b", "c")
+}
+
+func TestExpiredLeaseReturnsWorkToPending(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice", "bob")
+	alice, bob := testAddr("alice"), testAddr("bob")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	expiry, err := k.ClaimWork(ctx, workID, alice)
+	if err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+	work, _ := k.GetWork(ctx, workID)
+	if work.Status != types.WorkStatusValidating || work.ClaimedBy != alice || work.LeaseExpiresAt != expiry || expiry <= ctx.BlockHeight() {
+		t.Fatalf("claimed work is %s leased to %q until %d, want validating leased to alice until %d", work.Status, work.ClaimedBy, work.LeaseExpiresAt, expiry)
+	}
+	if _, err := k.ClaimWork(ctx, workID, bob); !errors.Is(err, types.ErrWorkNotPending) {
+		t.Fatalf("claiming leased work returned %v, want %v", err, types.ErrWorkNotPending)
+	}
+
+	// The lease holds up to its expiry height
+	k.ExpireLeases(ctx.WithBlockHeight(expiry - 1))
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusValidating || work.ClaimedBy != alice {
+		t.Fatalf("work is %s leased to %q before its lease expired, want validating leased to alice", work.Status, work.ClaimedBy)
+	}
+
+	// Then the work returns to the queue without a lease holder
+	k.ExpireLeases(ctx.WithBlockHeight(expiry))
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusPending || work.ClaimedBy != "" || work.LeaseExpiresAt != 0 {
+		t.Fatalf("expired work is %s leased to %q until %d, want pending with no lease", work.Status, work.ClaimedBy, work.LeaseExpiresAt)
+	}
+	var leased []string
+	k.IterateExpiredLeases(ctx, expiry, func(work *types.WorkUnit) bool {
+		leased = append(leased, work.Id)
+		return false
+	})
+	if len(leased) != 0 {
+		t.Fatalf("expired leases still index %v", leased)
+	}
+
+	// Another validator can claim it afresh
+	later := ctx.WithBlockHeight(expiry + 1)
+	reclaimed, err := k.ClaimWork(later, workID, bob)
+	if err != nil {
+		t.Fatalf("failed to reclaim work after its lease expired: %v", err)
+	}
+	if leaseBlocks := expiry - ctx.BlockHeight(); reclaimed != later.BlockHeight()+leaseBlocks {
+		t.Fatalf("reclaimed lease expires at %d, want %d", reclaimed, later.BlockHeight()+leaseBlocks)
+	}
+	if work, _ := k.GetWork(ctx, workID); work.ClaimedBy != bob {
+		t.Fatalf("reclaimed work is leased to %q, want bob", work.ClaimedBy)
+	}
+}
+
+func TestResultsRequireTheLease(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice", "bob")
+	alice, bob := testAddr("alice"), testAddr("bob")
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	// Unclaimed work takes no results
+	if err := k.ValidateWork(ctx, workID, alice, true, 90, "proof"); !errors.Is(err, types.ErrWorkNotClaimed) {
+		t.Fatalf("validating unclaimed work returned %v, want %v", err, types.ErrWorkNotClaimed)
+	}
+
+	expiry, err := k.ClaimWork(ctx, workID, alice)
+	if err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+
+	// Only the lease holder submits results
+	if err := k.ValidateWork(ctx, workID, bob, true, 90, "proof"); !errors.Is(err, types.ErrNotLeaseHolder) {
+		t.Fatalf("validating work leased to another validator returned %v, want %v", err, types.ErrNotLeaseHolder)
+	}
+	if err := k.RejectWork(ctx, workID, bob, "malformed"); !errors.Is(err, types.ErrNotLeaseHolder) {
+		t.Fatalf("rejecting work leased to another validator returned %v, want %v", err, types.ErrNotLeaseHolder)
+	}
+
+	// Nor once the lease has ended, even before it is expired
+	ended := ctx.WithBlockHeight(expiry + 1)
+	if err := k.ValidateWork(ended, workID, alice, true, 90, "proof"); !errors.Is(err, types.ErrLeaseExpired) {
+		t.Fatalf("validating work after its lease ended returned %v, want %v", err, types.ErrLeaseExpired)
+	}
+	if err := k.RejectWork(ended, workID, alice, "malformed"); !errors.Is(err, types.ErrLeaseExpired) {
+		t.Fatalf("rejecting work after its lease ended returned %v, want %v", err, types.ErrLeaseExpired)
+	}
+
+	// Within the lease the result is accepted and the lease released
+	if err := k.ValidateWork(ctx.WithBlockHeight(expiry), workID, alice, true, 90, "proof"); err != nil {
+		t.Fatalf("failed to validate work on its lease's last block: %v", err)
+	}
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusValidated || work.ClaimedBy != "" || work.LeaseExpiresAt != 0 {
+		t.Fatalf("validated work is %s leased to %q until %d, want validated with no lease", work.Status, work.ClaimedBy, work.LeaseExpiresAt)
+	}
+}
+
+func TestUnbondedSignersAreRejected(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	alice := bondValidators(t, k, ctx, bank, "alice")[0]
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	// Validators registered at genesis must still bond before taking work
+	genesisValidator := testAddr("genesis")
+	k.SetValidatorStats(ctx, &types.ValidatorStats{Address: genesisValidator})
+
+	for signer, wantErr := range map[string]error{
+		testAddr("mallory"): types.ErrUnknownValidator,
+		genesisValidator:    types.ErrInsufficientBond,
+	} {
+		if _, err := k.ClaimWork(ctx, workID, signer); !errors.Is(err, wantErr) {
+			t.Fatalf("claiming work as an unbonded signer returned %v, want %v", err, wantErr)
+		}
+	}
+	if _, err := k.ClaimWork(ctx, workID, alice); err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+	for signer, wantErr := range map[string]error{
+		testAddr("mallory"): types.ErrUnknownValidator,
+		genesisValidator:    types.ErrInsufficientBond,
+	} {
+		if err := k.ValidateWork(ctx, workID, signer, true, 90, "proof"); !errors.Is(err, wantErr) {
+			t.Fatalf("validating work as an unbonded signer returned %v, want %v", err, wantErr)
+		}
+		if err := k.RejectWork(ctx, workID, signer, "malformed"); !errors.Is(err, wantErr) {
+			t.Fatalf("rejecting work as an unbonded signer returned %v, want %v", err, wantErr)
+		}
+	}
+
+	// Unregistered signers gain no stats record
+	if _, found := k.GetValidatorStats(ctx, testAddr("mallory")); found {
+		t.Fatal("unregistered signer gained a stats record")
+	}
+}
+
+func TestUpdateParamsRequiresAuthority(t *testing.T) {
+	k, ctx, _ := newTestKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.LeaseBlocks = 20
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: testAddr("mallory"), Params: params}); !errors.Is(err, types.ErrInvalidAuthority) {
+		t.Fatalf("updating params from another address returned %v, want %v", err, types.ErrInvalidAuthority)
+	}
+
+	invalid := types.DefaultParams()
+	invalid.LeaseBlocks = 0
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid}); err == nil {
+		t.Fatal("updating to invalid params succeeded")
+	}
+	if blocks := k.GetParams(ctx).LeaseBlocks; blocks != types.DefaultLeaseBlocks {
+		t.Fatalf("lease blocks are %d after refused updates, want %d", blocks, types.DefaultLeaseBlocks)
+	}
+
+	if _, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}); err != nil {
+		t.Fatalf("failed to update params: %v", err)
+	}
+	if blocks := k.GetParams(ctx).LeaseBlocks; blocks != 20 {
+		t.Fatalf("lease blocks are %d, want 20", blocks)
+	}
+}
+
+func TestSubmitWorkReservesContentHashIDs(t *testing.T) {
+	k, ctx, _ := newBankedKeeper()
+
+	data := []byte(`{"block":1}`)
+	contentID := types.WorkID(types.WorkTypeCrypto, data)
+	otherID := types.WorkID(types.WorkTypeCrypto, []byte(`{"block":2}`))
+
+	// Taking the ID another submission hashes to is rejected
+	squatter := &types.WorkUnit{Id: otherID, Type: types.WorkTypeCrypto, Data: data}
+	if err := k.SubmitWork(ctx, squatter); !errors.Is(err, types.ErrInvalidWorkID) {
+		t.Fatalf("submitting under another work's content ID returned %v, want %v", err, types.ErrInvalidWorkID)
+	}
+
+	// The work's own content ID, and IDs outside the hash format, are accepted
+	for _, id := range []string{contentID, "custom-id", strings.ToUpper(otherID)} {
+		work := &types.WorkUnit{Id: id, Type: types.WorkTypeCrypto, Data: data}
+		if err := k.SubmitWork(ctx, work); err != nil {
+			t.Fatalf("submitting under %s failed: %v", id, err)
+		}
+	}
+}
+
+func TestUpdateParamsKeepsBondDenomWhileStaked(t *testing.T) {
+	k, ctx, _ := newBankedKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.BondDenom = "ustake"
+	update := &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+
+	// Stake bonded in the current denom pins it
+	validator := testAddr("validator")
+	k.SetValidatorBond(ctx, &types.ValidatorBond{
+		Validator: validator,
+		Amount:    types.NewProtoCoin(sdk.NewInt64Coin(types.DefaultBondDenom, 100)),
+	})
+	if _, err := msgServer.UpdateParams(ctx, update); !errors.Is(err, types.ErrInvalidParams) {
+		t.Fatalf("changing the bond denom under bonded stake returned %v, want %v", err, types.ErrInvalidParams)
+	}
+
+	// Stake left over from another denom cannot be unbonded as the bond denom
+	k.SetValidatorBond(ctx, &types.ValidatorBond{
+		Validator: validator,
+		Amount:    types.NewProtoCoin(sdk.NewInt64Coin("ustake", 100)),
+	})
+	if _, err := k.Unbond(ctx, validator, sdk.NewInt64Coin(types.DefaultBondDenom, 1)); !errors.Is(err, types.ErrInvalidBond) {
+		t.Fatalf("unbonding from a bond in another denom returned %v, want %v", err, types.ErrInvalidBond)
+	}
+
+	// Once no stake is held the denom can change
+	k.SetValidatorBond(ctx, &types.ValidatorBond{
+		Validator: validator,
+		Amount:    types.NewProtoCoin(sdk.NewInt64Coin(types.DefaultBondDenom, 0)),
+	})
+	if _, err := msgServer.UpdateParams(ctx, update); err != nil {
+		t.Fatalf("changing the bond denom without stake failed: %v", err)
+	}
+	if denom := k.GetParams(ctx).BondDenom; denom != "ustake" {
+		t.Fatalf("bond denom is %s, want ustake", denom)
+	}
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	v2 "github.com/maco144/pickle/x/workqueue/migrations/v2"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+//
+// Version 3 keeps the state in collections: values are encoded as before, but
+// composite keys are collections pairs instead of length-prefixed strings,
+// heights are sign-flipped and the counters move from bare string keys to
+// prefixes of their own. The migration reads every version 2 entry, clears the
+// version 2 prefixes and writes the entries back through the keeper, which
+// rebuilds the work indexes. Work whose ID, type or submitter contains a NUL
+// byte cannot be keyed and fails the migration.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	var (
+		params         types.Params
+		hasParams      bool
+		workTypes      []*types.WorkTypeDefinition
+		work           []*types.WorkUnit
+		validatorStats []*types.ValidatorStats
+		quorumRules    []*types.QuorumRule
+		votes          []*types.WorkVote
+		challenges     []*types.Challenge
+		challengeVotes []*types.WorkVote
+		commits        []*types.ValidationCommit
+		bonds          []*types.ValidatorBond
+		unbonding      []*types.UnbondingEntry
+		bounties       []*types.Bounty
+	)
+
+	if bz := store.Get(v2.KeyParams); bz != nil {
+		if err := k.cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+		hasParams = true
+	}
+	for _, read := range []error{
+		readV2Entries(k, store, v2.KeyPrefixWorkType, &workTypes),
+		readV2Entries(k, store, v2.KeyPrefixWorkUnit, &work),
+		readV2Entries(k, store, v2.KeyPrefixValidatorStats, &validatorStats),
+		readV2Entries(k, store, v2.KeyPrefixQuorumRule, &quorumRules),
+		readV2Entries(k, store, v2.KeyPrefixWorkVote, &votes),
+		readV2Entries(k, store, v2.KeyPrefixChallenge, &challenges),
+		readV2Entries(k, store, v2.KeyPrefixChallengeVote, &challengeVotes),
+		readV2Entries(k, store, v2.KeyPrefixValidationCommit, &commits),
+		readV2Entries(k, store, v2.KeyPrefixValidatorBond, &bonds),
+		readV2Entries(k, store, v2.KeyPrefixUnbonding, &unbonding),
+		readV2Entries(k, store, v2.KeyPrefixBounty, &bounties),
+	} {
+		if read != nil {
+			return read
+		}
+	}
+	totalSubmitted := sdk.BigEndianToUint64(store.Get(v2.KeyTotalSubmitted))
+	totalValidated := sdk.BigEndianToUint64(store.Get(v2.KeyTotalValidated))
+	totalRejected := sdk.BigEndianToUint64(store.Get(v2.KeyTotalRejected))
+
+	for _, w := range work {
+		if err := validateWorkKeys(w); err != nil {
+			return fmt.Errorf("work unit %q: %w", w.Id, err)
+		}
+	}
+	for _, list := range [][]*types.WorkVote{votes, challengeVotes} {
+		for _, vote := range list {
+			if err := types.ValidateKeyComponent(vote.WorkId, types.MaxWorkIDLength); err != nil {
+				return fmt.Errorf("vote on work %q: %w", vote.WorkId, err)
+			}
+		}
+	}
+	for _, commit := range commits {
+		if err := types.ValidateKeyComponent(commit.WorkId, types.MaxWorkIDLength); err != nil {
+			return fmt.Errorf("commitment on work %q: %w", commit.WorkId, err)
+		}
+	}
+
+	for _, p := range [][]byte{
+		v2.KeyPrefixWorkUnit, v2.KeyPrefixValidatorStats, v2.KeyWorkQueue,
+		v2.KeyPrefixWorkByStatus, v2.KeyPrefixWorkByType, v2.KeyPrefixWorkBySubmittedAt,
+		v2.KeyPrefixLeaseExpiry, v2.KeyPrefixWorkBySubmitter, v2.KeyPrefixQuorumRule,
+		v2.KeyPrefixWorkVote, v2.KeyPrefixChallenge, v2.KeyPrefixChallengeVote,
+		v2.KeyPrefixValidatorBond, v2.KeyPrefixUnbonding, v2.KeyPrefixBounty,
+		v2.KeyPrefixWorkExpiry, v2.KeyParams, v2.KeyPrefixWorkType,
+		v2.KeyPrefixPendingByPriority, v2.KeyPrefixValidationCommit, v2.KeyPrefixRevealExpiry,
+	} {
+		clearPrefix(store, p)
+	}
+	store.Delete(v2.KeyTotalSubmitted)
+	store.Delete(v2.KeyTotalValidated)
+	store.Delete(v2.KeyTotalRejected)
+
+	// Params were validated when stored, so they are written as they are,
+	// apart from the commit window version 2 did not have
+	if hasParams {
+		if params.CommitBlocks == 0 {
+			params.CommitBlocks = types.DefaultCommitBlocks
+		}
+		if err := k.params.Set(ctx, &params); err != nil {
+			return err
+		}
+	}
+	for _, def := range workTypes {
+		if err := k.SetWorkTypeDefinition(ctx, def); err != nil {
+			return fmt.Errorf("work type %q: %w", def.Name, err)
+		}
+	}
+	for _, w := range work {
+		k.SetWork(ctx, w)
+	}
+	for _, stats := range validatorStats {
+		k.SetValidatorStats(ctx, stats)
+	}
+	for _, rule := range quorumRules {
+		k.SetQuorumRule(ctx, rule)
+	}
+	for _, vote := range votes {
+		k.SetWorkVote(ctx, vote)
+	}
+	for _, challenge := range challenges {
+		k.SetChallenge(ctx, challenge)
+	}
+	for _, vote := range challengeVotes {
+		k.SetChallengeVote(ctx, vote)
+	}
+	for _, commit := range commits {
+		k.SetValidationCommit(ctx, commit)
+	}
+	for _, bond := range bonds {
+		k.SetValidatorBond(ctx, bond)
+	}
+	for _, entry := range unbonding {
+		k.SetUnbondingEntry(ctx, entry)
+	}
+	for _, bounty := range bounties {
+		k.SetBounty(ctx, bounty)
+	}
+	k.SetTotalWorkSubmitted(ctx, totalSubmitted)
+	k.SetTotalWorkValidated(ctx, totalValidated)
+	k.SetTotalWorkRejected(ctx, totalRejected)
+
+	return nil
+}
+
+// validateWorkKeys checks that the fields of a work unit used in collection
+// keys can be encoded
+func validateWorkKeys(work *types.WorkUnit) error {
+	if err := types.ValidateKeyComponent(work.Id, types.MaxWorkIDLength); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+	if err := types.ValidateKeyComponent(work.Type, types.MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("type: %w", err)
+	}
+	if err := types.ValidateKeyComponent(work.Submitter, types.MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("submitter: %w", err)
+	}
+	return nil
+}
+
+// readV2Entries decodes every value stored under a version 2 prefix into out
+func readV2Entries[T any, PT interface {
+	*T
+	gogoproto.Message
+}](k Keeper, store storetypes.KVStore, keyPrefix []byte, out *[]PT) error {
+	iterator := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		value := PT(new(T))
+		if err := k.cdc.Unmarshal(iterator.Value(), value); err != nil {
+			return err
+		}
+		*out = append(*out, value)
+	}
+	return nil
+}
+
+// clearPrefix deletes every entry under a key prefix
+func clearPrefix(store storetypes.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	v2 "github.com/maco144/pickle/x/workqueue/migrations/v2"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// writeV1Store writes state in the consensus version 1 layout: work units and
+// validator stats under their prefixes and the bare counter keys, with no
+// params, work types, votes or indexes
+func writeV1Store(store interface{ Set(key, value []byte) }) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	alice := testAddr("alice")
+	bob := testAddr("bob")
+
+	units := []*types.WorkUnit{
+		{Id: "first", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 5, Status: types.WorkStatusPending},
+		{Id: "second", Type: types.WorkTypeMLData, Data: []byte(`{}`), SubmittedAt: 8, Status: types.WorkStatusPending, Submitter: bob},
+		{Id: "valid", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 6, ValidatedAt: 10, Validator: alice, Status: types.WorkStatusValidated, Confidence: 90, Proof: "checked"},
+		{Id: "invalid", Type: types.WorkTypeSupplyChain, Data: []byte(`{}`), SubmittedAt: 7, ValidatedAt: 12, Validator: bob, Status: types.WorkStatusRejected, Proof: "malformed"},
+	}
+	for _, work := range units {
+		store.Set(v2.WorkUnitKey(work.Id), cdc.MustMarshal(work))
+	}
+
+	store.Set(v2.ValidatorStatsKey(alice), cdc.MustMarshal(&types.ValidatorStats{
+		Address: alice, TotalWorkValidated: 1, Specializations: map[string]uint64{types.WorkTypeCrypto: 1}, AverageConfidence: 90, LastActiveAt: 10,
+	}))
+	store.Set(v2.ValidatorStatsKey(bob), cdc.MustMarshal(&types.ValidatorStats{
+		Address: bob, TotalWorkRejected: 1, Specializations: map[string]uint64{types.WorkTypeSupplyChain: 1}, LastActiveAt: 12,
+	}))
+
+	store.Set(v2.KeyTotalSubmitted, sdk.Uint64ToBigEndian(4))
+	store.Set(v2.KeyTotalValidated, sdk.Uint64ToBigEndian(1))
+	store.Set(v2.KeyTotalRejected, sdk.Uint64ToBigEndian(1))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, key := newTestKeeper()
+	ctx = ctx.WithBlockHeight(1000)
+	writeV1Store(ctx.KVStore(key))
+
+	// Version 1 work is invisible to the indexed queries
+	if pending := k.GetPendingWork(ctx); len(pending) != 0 {
+		t.Fatalf("%d pending work units before migration, want 0", len(pending))
+	}
+
+	migrator := keeper.NewMigrator(k)
+	if err := migrator.Migrate1to2(ctx); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	if !ctx.KVStore(key).Has(v2.KeyParams) {
+		t.Fatal("params were not stored")
+	}
+
+	// The keeper reads the current layout
+	if err := migrator.Migrate2to3(ctx); err != nil {
+		t.Fatalf("migration to version 3 failed: %v", err)
+	}
+	params := k.GetParams(ctx)
+	for _, name := range []string{types.WorkTypeCrypto, types.WorkTypeSupplyChain, types.WorkTypeMLData} {
+		if _, found := k.GetWorkTypeDefinition(ctx, name); !found {
+			t.Fatalf("work type %s was not registered", name)
+		}
+	}
+
+	pending := k.GetPendingWork(ctx)
+	if len(pending) != 2 || pending[0].Id != "first" || pending[1].Id != "second" {
+		t.Fatalf("pending work after migration is %v, want [first second]", pending)
+	}
+	for _, work := range pending {
+		if want := 1000 + params.WorkExpiryBlocks; work.ExpiresAt != want {
+			t.Fatalf("work %s expires at %d, want %d", work.Id, work.ExpiresAt, want)
+		}
+	}
+
+	var bySubmitter []string
+	k.IterateWorkBySubmitter(ctx, testAddr("bob"), func(work *types.WorkUnit) bool {
+		bySubmitter = append(bySubmitter, work.Id)
+		return false
+	})
+	if len(bySubmitter) != 1 || bySubmitter[0] != "second" {
+		t.Fatalf("work by submitter is %v, want [second]", bySubmitter)
+	}
+
+	vote, found := k.GetWorkVote(ctx, "valid", testAddr("alice"))
+	if !found || !vote.Valid || vote.Confidence != 90 || vote.VotedAt != 10 {
+		t.Fatalf("vote on validated work is %v, want a valid vote with confidence 90 at height 10", vote)
+	}
+	vote, found = k.GetWorkVote(ctx, "invalid", testAddr("bob"))
+	if !found || vote.Valid || vote.Proof != "malformed" {
+		t.Fatalf("vote on rejected work is %v, want an invalid vote", vote)
+	}
+
+	// Pending work survives the first end block after the upgrade
+	if err := k.ExpireWork(ctx.WithBlockHeight(1001)); err != nil {
+		t.Fatal(err)
+	}
+	if pending := k.GetPendingWork(ctx); len(pending) != 2 {
+		t.Fatalf("%d pending work units after the upgrade block, want 2", len(pending))
+	}
+
+	for _, inv := range k.Invariants() {
+		if msg, broken := inv.Invariant(ctx); broken {
+			t.Fatal(msg)
+		}
+	}
+}
+
+func TestMigrate1to2Idempotent(t *testing.T) {
+	k, ctx, key := newTestKeeper()
+	ctx = ctx.WithBlockHeight(1000)
+	writeV1Store(ctx.KVStore(key))
+
+	migrator := keeper.NewMigrator(k)
+	if err := migrator.Migrate1to2(ctx); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	want := storeContents(ctx, key)
+
+	if err := migrator.Migrate1to2(ctx.WithBlockHeight(2000)); err != nil {
+		t.Fatalf("second migration failed: %v", err)
+	}
+	got := storeContents(ctx, key)
+
+	if len(got) != len(want) {
+		t.Fatalf("store has %d entries after a second migration, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if string(want[i][0]) != string(got[i][0]) || string(want[i][1]) != string(got[i][1]) {
+			t.Fatalf("store entry %x changed on a second migration", want[i][0])
+		}
+	}
+}
+
+func TestMigrate1to2RejectsUnindexableWork(t *testing.T) {
+	k, ctx, key := newTestKeeper()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	work := &types.WorkUnit{Id: string(make([]byte, v2.MaxWorkIDLength+1)), Type: types.WorkTypeCrypto, Status: types.WorkStatusPending}
+	ctx.KVStore(key).Set(v2.WorkUnitKey(work.Id), cdc.MustMarshal(work))
+
+	if err := keeper.NewMigrator(k).Migrate1to2(ctx); err == nil {
+		t.Fatal("migration accepted a work unit too long to index")
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx, key := newTestKeeper()
+	ctx = ctx.WithBlockHeight(1000)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+
+	store := ctx.KVStore(key)
+	writeV1Store(store)
+	migrator := keeper.NewMigrator(k)
+	if err := migrator.Migrate1to2(ctx); err != nil {
+		t.Fatalf("migration to version 2 failed: %v", err)
+	}
+
+	// Version 2 entries under composite keys the first migration does not write
+	claimed := &types.WorkUnit{Id: "claimed", Type: types.WorkTypeCrypto, Data: []byte(`{}`), SubmittedAt: 9, Status: types.WorkStatusValidating, ClaimedBy: alice, LeaseExpiresAt: 1010, ExpiresAt: 1100}
+	store.Set(v2.WorkUnitKey(claimed.Id), cdc.MustMarshal(claimed))
+	for _, indexKey := range v2.WorkIndexKeys(claimed) {
+		store.Set(indexKey, []byte{})
+	}
+	store.Set(v2.KeyTotalSubmitted, sdk.Uint64ToBigEndian(5))
+	store.Set(v2.ValidationCommitKey("claimed", bob), cdc.MustMarshal(&types.ValidationCommit{WorkId: "claimed", Validator: bob, Commitment: []byte{0x01}, CommittedAt: 990}))
+	store.Set(v2.ValidatorBondKey(alice), cdc.MustMarshal(&types.ValidatorBond{Validator: alice, Amount: types.NewProtoCoin(sdk.NewInt64Coin("upickle", 100))}))
+	store.Set(v2.UnbondingKey(1200, bob), cdc.MustMarshal(&types.UnbondingEntry{Validator: bob, Amount: types.NewProtoCoin(sdk.NewInt64Coin("upickle", 50)), CompletionHeight: 1200}))
+
+	if err := migrator.Migrate2to3(ctx); err != nil {
+		t.Fatalf("migration to version 3 failed: %v", err)
+	}
+
+	if store.Has(v2.KeyTotalSubmitted) || store.Has(v2.WorkByStatusKey(types.WorkStatusPending, "first")) {
+		t.Fatal("version 2 keys remain after the migration")
+	}
+	if total := k.GetTotalWorkSubmitted(ctx); total != 5 {
+		t.Fatalf("total submitted is %d, want 5", total)
+	}
+	if pending := k.GetPendingWork(ctx); len(pending) != 2 {
+		t.Fatalf("%d pending work units, want 2", len(pending))
+	}
+
+	var leases []string
+	k.IterateExpiredLeases(ctx.WithBlockHeight(1010), 1010, func(work *types.WorkUnit) bool {
+		leases = append(leases, work.Id)
+		return false
+	})
+	if len(leases) != 1 || leases[0] != "claimed" {
+		t.Fatalf("expired leases are %v, want [claimed]", leases)
+	}
+	if _, found := k.GetValidationCommit(ctx, "claimed", bob); !found {
+		t.Fatal("commitment was not migrated")
+	}
+	if _, found := k.GetWorkVote(ctx, "valid", alice); !found {
+		t.Fatal("vote was not migrated")
+	}
+	if _, found := k.GetValidatorBond(ctx, alice); !found {
+		t.Fatal("bond was not migrated")
+	}
+	var unbonding []*types.UnbondingEntry
+	k.IterateUnbonding(ctx, 1200, func(entry *types.UnbondingEntry) bool {
+		unbonding = append(unbonding, entry)
+		return false
+	})
+	if len(unbonding) != 1 || unbonding[0].Validator != bob {
+		t.Fatalf("unbonding entries are %v, want bob's", unbonding)
+	}
+
+	for _, inv := range k.Invariants() {
+		if msg, broken := inv.Invariant(ctx); broken {
+			t.Fatal(msg)
+		}
+	}
+}
+
+func TestMigrate2to3RejectsUnkeyableWork(t *testing.T) {
+	k, ctx, key := newTestKeeper()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	work := &types.WorkUnit{Id: "nul\x00id", Type: types.WorkTypeCrypto, Status: types.WorkStatusValidated}
+	ctx.KVStore(key).Set(v2.WorkUnitKey(work.Id), cdc.MustMarshal(work))
+
+	if err := keeper.NewMigrator(k).Migrate2to3(ctx); err == nil {
+		t.Fatal("migration accepted a work ID containing a NUL byte")
+	}
+}
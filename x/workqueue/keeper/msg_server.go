@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maco144/pickle/x/workqueue/types"
 )
@@ -22,11 +23,18 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 func (ms msgServer) SubmitWork(goCtx context.Context, msg *types.MsgSubmitWork) (*types.MsgSubmitWorkResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The submitter owns the work and is recorded on it
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubmitter, "invalid submitter address: %s", err)
+	}
+
 	// Create work unit from message
 	work := &types.WorkUnit{
-		Id:   msg.WorkId,
-		Type: msg.WorkType,
-		Data: msg.WorkData,
+		Id:        msg.WorkId,
+		Type:      msg.WorkType,
+		Data:      msg.WorkData,
+		Submitter: msg.Submitter,
+		Priority:  msg.Priority,
 	}
 
 	// Submit the work
@@ -34,11 +42,37 @@ func (ms msgServer) SubmitWork(goCtx context.Context, msg *types.MsgSubmitWork)
 		return nil, err
 	}
 
+	// Escrow the optional bounty until the work leaves the queue
+	if msg.Bounty != nil {
+		bounty, err := types.SDKCoin(msg.Bounty)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidBounty, err.Error())
+		}
+		if err := ms.Keeper.EscrowBounty(ctx, work.Id, msg.Submitter, bounty); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgSubmitWorkResponse{
 		WorkId: work.Id,
 	}, nil
 }
 
+// ClaimWork implements the MsgServer.ClaimWork method
+func (ms msgServer) ClaimWork(goCtx context.Context, msg *types.MsgClaimWork) (*types.MsgClaimWorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lease the work to the validator
+	leaseExpiresAt, err := ms.Keeper.ClaimWork(ctx, msg.WorkId, msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimWorkResponse{
+		LeaseExpiresAt: leaseExpiresAt,
+	}, nil
+}
+
 // ValidateWork implements the MsgServer.ValidateWork method
 func (ms msgServer) ValidateWork(goCtx context.Context, msg *types.MsgValidateWork) (*types.MsgValidateWorkResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -51,6 +85,30 @@ func (ms msgServer) ValidateWork(goCtx context.Context, msg *types.MsgValidateWo
 	return &types.MsgValidateWorkResponse{}, nil
 }
 
+// CommitValidation implements the MsgServer.CommitValidation method
+func (ms msgServer) CommitValidation(goCtx context.Context, msg *types.MsgCommitValidation) (*types.MsgCommitValidationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Seal the verdict until the round is full
+	if err := ms.Keeper.CommitValidation(ctx, msg.WorkId, msg.Validator, msg.Commitment); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitValidationResponse{}, nil
+}
+
+// RevealValidation implements the MsgServer.RevealValidation method
+func (ms msgServer) RevealValidation(goCtx context.Context, msg *types.MsgRevealValidation) (*types.MsgRevealValidationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check the verdict against its commitment and count it
+	if err := ms.Keeper.RevealValidation(ctx, msg.WorkId, msg.Validator, msg.Valid, msg.Confidence, msg.Proof, msg.Salt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealValidationResponse{}, nil
+}
+
 // RejectWork implements the MsgServer.RejectWork method
 func (ms msgServer) RejectWork(goCtx context.Context, msg *types.MsgRejectWork) (*types.MsgRejectWorkResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -62,3 +120,95 @@ func (ms msgServer) RejectWork(goCtx context.Context, msg *types.MsgRejectWork)
 
 	return &types.MsgRejectWorkResponse{}, nil
 }
+
+// ChallengeWork implements the MsgServer.ChallengeWork method
+func (ms msgServer) ChallengeWork(goCtx context.Context, msg *types.MsgChallengeWork) (*types.MsgChallengeWorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bond, err := types.SDKCoin(msg.Bond)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBond, err.Error())
+	}
+
+	// Escrow the bond and queue the work for re-validation
+	if err := ms.Keeper.ChallengeWork(ctx, msg.Challenger, msg.WorkId, bond, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChallengeWorkResponse{}, nil
+}
+
+// Bond implements the MsgServer.Bond method
+func (ms msgServer) Bond(goCtx context.Context, msg *types.MsgBond) (*types.MsgBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := types.SDKCoin(msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBond, err.Error())
+	}
+
+	// Escrow the stake and register the validator
+	if err := ms.Keeper.Bond(ctx, msg.Validator, amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBondResponse{}, nil
+}
+
+// Unbond implements the MsgServer.Unbond method
+func (ms msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.MsgUnbondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := types.SDKCoin(msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBond, err.Error())
+	}
+
+	// Queue the stake for return after the unbonding period
+	completionHeight, err := ms.Keeper.Unbond(ctx, msg.Validator, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondResponse{
+		CompletionHeight: completionHeight,
+	}, nil
+}
+
+// UpdateParams implements the MsgServer.UpdateParams method
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", ms.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	// Bonds and unbonding entries hold stake in the bond denom, so it cannot
+	// change under them
+	if msg.Params != nil {
+		if bondDenom := ms.Keeper.GetParams(ctx).BondDenom; msg.Params.BondDenom != bondDenom && ms.Keeper.hasStake(ctx) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidParams, "cannot change the bond denom from %s to %s while stake is bonded or unbonding", bondDenom, msg.Params.BondDenom)
+		}
+	}
+
+	if err := ms.Keeper.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetWorkTypeDefinition implements the MsgServer.SetWorkTypeDefinition method
+func (ms msgServer) SetWorkTypeDefinition(goCtx context.Context, msg *types.MsgSetWorkTypeDefinition) (*types.MsgSetWorkTypeDefinitionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", ms.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.Keeper.SetWorkTypeDefinition(ctx, msg.Definition); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWorkTypeDefinition, err.Error())
+	}
+
+	return &types.MsgSetWorkTypeDefinitionResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetParams returns the module parameters, falling back to the defaults when
+// none are set
+func (k Keeper) GetParams(ctx sdk.Context) *types.Params {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams()
+	}
+	must(err)
+	return params
+}
+
+// SetParams validates and stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return k.params.Set(ctx, params)
+}
+
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
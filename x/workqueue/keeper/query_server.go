@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -45,12 +46,220 @@ func (qs queryServer) PendingWork(goCtx context.Context, req *types.QueryPending
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	filter := &types.WorkFilter{}
+	if req.Filter != nil {
+		if req.Filter.Status != "" && req.Filter.Status != types.WorkStatusPending {
+			return nil, status.Error(codes.InvalidArgument, "pending work filter cannot select another status")
+		}
+		filter.WorkType = req.Filter.WorkType
+		filter.Submitter = req.Filter.Submitter
+		filter.MinSubmittedAt = req.Filter.MinSubmittedAt
+		filter.MaxSubmittedAt = req.Filter.MaxSubmittedAt
+	}
+	filter.Status = types.WorkStatusPending
+
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pending := qs.Keeper.GetPendingWork(ctx)
+	pending, pageRes, err := qs.Keeper.ListWork(ctx, filter, types.SDKPageRequest(req.Pagination))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryPendingWorkResponse{
 		PendingWork: pending,
+		Pagination:  types.NewPageResponse(pageRes),
+	}, nil
+}
+
+// ListWork implements the Query.ListWork method
+func (qs queryServer) ListWork(goCtx context.Context, req *types.QueryListWorkRequest) (*types.QueryListWorkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.Filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	works, pageRes, err := qs.Keeper.ListWork(ctx, req.Filter, types.SDKPageRequest(req.Pagination))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListWorkResponse{
+		Work:       works,
+		Pagination: types.NewPageResponse(pageRes),
+	}, nil
+}
+
+// WorkBySubmitter implements the Query.WorkBySubmitter method
+func (qs queryServer) WorkBySubmitter(goCtx context.Context, req *types.QueryWorkBySubmitterRequest) (*types.QueryWorkBySubmitterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Submitter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid submitter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	works, pageRes, err := qs.Keeper.ListWork(ctx, &types.WorkFilter{Submitter: req.Submitter}, types.SDKPageRequest(req.Pagination))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWorkBySubmitterResponse{
+		Work:       works,
+		Pagination: types.NewPageResponse(pageRes),
+	}, nil
+}
+
+// WorkVotes implements the Query.WorkVotes method
+func (qs queryServer) WorkVotes(goCtx context.Context, req *types.QueryWorkVotesRequest) (*types.QueryWorkVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	work, found := qs.Keeper.GetWork(ctx, req.WorkId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "work not found")
+	}
+
+	votes := qs.Keeper.GetWorkVotes(ctx, work.Id)
+
+	return &types.QueryWorkVotesResponse{
+		Votes: votes,
+		Tally: types.NewVoteTally(votes, qs.Keeper.GetQuorumRule(ctx, work.Type)),
+	}, nil
+}
+
+// ValidationCommits implements the Query.ValidationCommits method
+func (qs queryServer) ValidationCommits(goCtx context.Context, req *types.QueryValidationCommitsRequest) (*types.QueryValidationCommitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := qs.Keeper.GetWork(ctx, req.WorkId); !found {
+		return nil, status.Error(codes.NotFound, "work not found")
+	}
+
+	return &types.QueryValidationCommitsResponse{
+		Commits: qs.Keeper.GetValidationCommits(ctx, req.WorkId),
+	}, nil
+}
+
+// Challenge implements the Query.Challenge method
+func (qs queryServer) Challenge(goCtx context.Context, req *types.QueryChallengeRequest) (*types.QueryChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenge, found := qs.Keeper.GetChallenge(ctx, req.WorkId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "challenge not found")
+	}
+
+	work, found := qs.Keeper.GetWork(ctx, req.WorkId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "work not found")
+	}
+
+	votes := qs.Keeper.GetChallengeVotes(ctx, req.WorkId)
+
+	return &types.QueryChallengeResponse{
+		Challenge: challenge,
+		Votes:     votes,
+		Tally:     types.NewVoteTally(votes, qs.Keeper.GetQuorumRule(ctx, work.Type)),
+	}, nil
+}
+
+// WorkType implements the Query.WorkType method
+func (qs queryServer) WorkType(goCtx context.Context, req *types.QueryWorkTypeRequest) (*types.QueryWorkTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	def, found := qs.Keeper.GetWorkTypeDefinition(ctx, req.Name)
+	if !found {
+		return nil, status.Error(codes.NotFound, "work type not found")
+	}
+
+	return &types.QueryWorkTypeResponse{
+		Definition: def,
+	}, nil
+}
+
+// WorkTypes implements the Query.WorkTypes method
+func (qs queryServer) WorkTypes(goCtx context.Context, req *types.QueryWorkTypesRequest) (*types.QueryWorkTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	defs, pageRes, err := query.CollectionPaginate(ctx, qs.workTypes, types.SDKPageRequest(req.Pagination),
+		func(_ string, def *types.WorkTypeDefinition) (*types.WorkTypeDefinition, error) {
+			return def, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryWorkTypesResponse{
+		Definitions: defs,
+		Pagination:  types.NewPageResponse(pageRes),
+	}, nil
+}
+
+// Bounty implements the Query.Bounty method
+func (qs queryServer) Bounty(goCtx context.Context, req *types.QueryBountyRequest) (*types.QueryBountyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bounty, found := qs.Keeper.GetBounty(ctx, req.WorkId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bounty not found")
+	}
+
+	return &types.QueryBountyResponse{
+		Bounty: bounty,
+	}, nil
+}
+
+// ValidatorBond implements the Query.ValidatorBond method
+func (qs queryServer) ValidatorBond(goCtx context.Context, req *types.QueryValidatorBondRequest) (*types.QueryValidatorBondResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bond, found := qs.Keeper.GetValidatorBond(ctx, req.ValidatorAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "validator bond not found")
+	}
+
+	return &types.QueryValidatorBondResponse{
+		Bond:      bond,
+		Unbonding: qs.Keeper.GetUnbondingEntries(ctx, req.ValidatorAddress),
 	}, nil
 }
 
@@ -86,3 +295,41 @@ func (qs queryServer) TotalStats(goCtx context.Context, req *types.QueryTotalSta
 		TotalRejected:  qs.Keeper.GetTotalWorkRejected(ctx),
 	}, nil
 }
+
+// CheckInvariants implements the Query.CheckInvariants method
+func (qs queryServer) CheckInvariants(goCtx context.Context, req *types.QueryCheckInvariantsRequest) (*types.QueryCheckInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !qs.Keeper.invariantsQuery {
+		return nil, status.Error(codes.Unavailable, "the invariants query is disabled on this node")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	invariants := qs.Keeper.Invariants()
+	results := make([]*types.InvariantResult, 0, len(invariants))
+	for _, inv := range invariants {
+		msg, broken := inv.Invariant(ctx)
+		results = append(results, &types.InvariantResult{
+			Route:   inv.Route,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
+
+	return &types.QueryCheckInvariantsResponse{Invariants: results}, nil
+}
+
+// Params implements the Query.Params method
+func (qs queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: qs.Keeper.GetParams(ctx),
+	}, nil
+}
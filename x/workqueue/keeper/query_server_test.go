@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"reflect"
+	"testing"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// listedWork stores five work units across statuses, types, submitters and
+// heights, in the same order by ID and by height
+func listedWork(t *testing.T) (types.QueryServer, sdk.Context) {
+	t.Helper()
+
+	k, ctx, _ := newTestKeeper()
+	alice, bob := testAddr("alice"), testAddr("bob")
+	for _, work := range []*types.WorkUnit{
+		{Id: "a", Type: types.WorkTypeCrypto, Status: types.WorkStatusPending, Submitter: alice, SubmittedAt: 10},
+		{Id: "b", Type: types.WorkTypeCrypto, Status: types.WorkStatusValidated, Submitter: bob, SubmittedAt: 11},
+		{Id: "c", Type: types.WorkTypeMLData, Status: types.WorkStatusPending, Submitter: bob, SubmittedAt: 12},
+		{Id: "d", Type: types.WorkTypeMLData, Status: types.WorkStatusRejected, Submitter: alice, SubmittedAt: 13},
+		{Id: "e", Type: types.WorkTypeCrypto, Status: types.WorkStatusPending, Submitter: alice, SubmittedAt: 14},
+	} {
+		k.SetWork(ctx, work)
+	}
+	return keeper.NewQueryServerImpl(k), ctx
+}
+
+// ids returns the IDs of a list of work units
+func ids(works []*types.WorkUnit) []string {
+	var ids []string
+	for _, work := range works {
+		ids = append(ids, work.Id)
+	}
+	return ids
+}
+
+func TestListWorkFilters(t *testing.T) {
+	qs, ctx := listedWork(t)
+	alice, bob := testAddr("alice"), testAddr("bob")
+
+	tests := []struct {
+		name   string
+		filter *types.WorkFilter
+		want   []string
+	}{
+		{"no filter", nil, []string{"a", "b", "c", "d", "e"}},
+		{"empty filter", &types.WorkFilter{}, []string{"a", "b", "c", "d", "e"}},
+		{"status", &types.WorkFilter{Status: types.WorkStatusPending}, []string{"a", "c", "e"}},
+		{"type", &types.WorkFilter{WorkType: types.WorkTypeCrypto}, []string{"a", "b", "e"}},
+		{"submitter", &types.WorkFilter{Submitter: alice}, []string{"a", "d", "e"}},
+		{"min height", &types.WorkFilter{MinSubmittedAt: 12}, []string{"c", "d", "e"}},
+		{"max height", &types.WorkFilter{MaxSubmittedAt: 12}, []string{"a", "b", "c"}},
+		{"height range", &types.WorkFilter{MinSubmittedAt: 11, MaxSubmittedAt: 13}, []string{"b", "c", "d"}},
+		{"status and type", &types.WorkFilter{Status: types.WorkStatusPending, WorkType: types.WorkTypeCrypto}, []string{"a", "e"}},
+		{"type and height", &types.WorkFilter{WorkType: types.WorkTypeMLData, MinSubmittedAt: 13}, []string{"d"}},
+		{"status and submitter", &types.WorkFilter{Status: types.WorkStatusPending, Submitter: bob}, []string{"c"}},
+		{"no match", &types.WorkFilter{Status: types.WorkStatusRejected, WorkType: types.WorkTypeCrypto}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := qs.ListWork(ctx, &types.QueryListWorkRequest{Filter: tc.filter})
+			if err != nil {
+				t.Fatalf("failed to list work: %v", err)
+			}
+			if got := ids(res.Work); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("listed %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPendingWorkFilters(t *testing.T) {
+	qs, ctx := listedWork(t)
+
+	tests := []struct {
+		name   string
+		filter *types.WorkFilter
+		want   []string
+	}{
+		{"no filter", nil, []string{"a", "c", "e"}},
+		{"pending status", &types.WorkFilter{Status: types.WorkStatusPending}, []string{"a", "c", "e"}},
+		{"type", &types.WorkFilter{WorkType: types.WorkTypeCrypto}, []string{"a", "e"}},
+		{"submitter and height", &types.WorkFilter{Submitter: testAddr("alice"), MaxSubmittedAt: 13}, []string{"a"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := qs.PendingWork(ctx, &types.QueryPendingWorkRequest{Filter: tc.filter})
+			if err != nil {
+				t.Fatalf("failed to query pending work: %v", err)
+			}
+			if got := ids(res.PendingWork); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("listed %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWorkFiltersRejectInvalidBounds(t *testing.T) {
+	qs, ctx := listedWork(t)
+
+	for name, query := range map[string]func() error{
+		"inverted heights": func() error {
+			_, err := qs.ListWork(ctx, &types.QueryListWorkRequest{Filter: &types.WorkFilter{MinSubmittedAt: 13, MaxSubmittedAt: 12}})
+			return err
+		},
+		"negative height": func() error {
+			_, err := qs.ListWork(ctx, &types.QueryListWorkRequest{Filter: &types.WorkFilter{MinSubmittedAt: -1}})
+			return err
+		},
+		"pending work in another status": func() error {
+			_, err := qs.PendingWork(ctx, &types.QueryPendingWorkRequest{Filter: &types.WorkFilter{Status: types.WorkStatusValidated}})
+			return err
+		},
+	} {
+		if err := query(); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("querying with %s returned %v, want %v", name, err, codes.InvalidArgument)
+		}
+	}
+}
+
+func TestListWorkPagination(t *testing.T) {
+	qs, ctx := listedWork(t)
+	list := func(filter *types.WorkFilter, page *queryv1beta1.PageRequest) *types.QueryListWorkResponse {
+		t.Helper()
+		res, err := qs.ListWork(ctx, &types.QueryListWorkRequest{Filter: filter, Pagination: page})
+		if err != nil {
+			t.Fatalf("failed to list work: %v", err)
+		}
+		return res
+	}
+
+	// Pages follow each other by key until the last one
+	for name, filter := range map[string]*types.WorkFilter{
+		"all work":     nil,
+		"status index": {Status: types.WorkStatusPending},
+		"type index":   {WorkType: types.WorkTypeCrypto, Submitter: testAddr("alice")},
+		"height index": {MinSubmittedAt: 11},
+	} {
+		var listed []string
+		var key []byte
+		for page := 0; ; page++ {
+			res := list(filter, &queryv1beta1.PageRequest{Key: key, Limit: 2})
+			if len(res.Work) > 2 {
+				t.Fatalf("%s page %d holds %d units, want at most 2", name, page, len(res.Work))
+			}
+			listed = append(listed, ids(res.Work)...)
+			if key = res.Pagination.GetNextKey(); key == nil {
+				break
+			}
+		}
+		if want := ids(list(filter, nil).Work); !reflect.DeepEqual(listed, want) {
+			t.Fatalf("paging through %s listed %v, want %v", name, listed, want)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		page  *queryv1beta1.PageRequest
+		want  []string
+		total uint64
+	}{
+		{"limit", &queryv1beta1.PageRequest{Limit: 2}, []string{"a", "b"}, 0},
+		{"offset", &queryv1beta1.PageRequest{Offset: 1, Limit: 2}, []string{"b", "c"}, 0},
+		{"offset past the end", &queryv1beta1.PageRequest{Offset: 5, Limit: 2}, nil, 0},
+		{"reverse", &queryv1beta1.PageRequest{Limit: 2, Reverse: true}, []string{"e", "d"}, 0},
+		{"reverse offset", &queryv1beta1.PageRequest{Offset: 1, Limit: 2, Reverse: true}, []string{"d", "c"}, 0},
+		{"count total", &queryv1beta1.PageRequest{Limit: 1, CountTotal: true}, []string{"a"}, 5},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := list(nil, tc.page)
+			if got := ids(res.Work); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("listed %v, want %v", got, tc.want)
+			}
+			if total := res.Pagination.GetTotal(); total != tc.total {
+				t.Fatalf("page counts %d units, want %d", total, tc.total)
+			}
+		})
+	}
+
+	// Filtered pages count and skip only matching units
+	res := list(&types.WorkFilter{Status: types.WorkStatusPending}, &queryv1beta1.PageRequest{Offset: 1, Limit: 1, CountTotal: true})
+	if got := ids(res.Work); !reflect.DeepEqual(got, []string{"c"}) || res.Pagination.GetTotal() != 3 {
+		t.Fatalf("second pending unit page listed %v of %d, want [c] of 3", got, res.Pagination.GetTotal())
+	}
+	res = list(&types.WorkFilter{Status: types.WorkStatusPending}, &queryv1beta1.PageRequest{Limit: 2, Reverse: true})
+	if got := ids(res.Work); !reflect.DeepEqual(got, []string{"e", "c"}) {
+		t.Fatalf("reversed pending units are %v, want [e c]", got)
+	}
+
+	// Height windows page within their bounds, forwards and in reverse
+	window := &types.WorkFilter{MinSubmittedAt: 11, MaxSubmittedAt: 13}
+	res = list(window, &queryv1beta1.PageRequest{Offset: 1, Limit: 1, CountTotal: true})
+	if got := ids(res.Work); !reflect.DeepEqual(got, []string{"c"}) || res.Pagination.GetTotal() != 3 {
+		t.Fatalf("second unit of the window listed %v of %d, want [c] of 3", got, res.Pagination.GetTotal())
+	}
+	res = list(window, &queryv1beta1.PageRequest{Limit: 2, Reverse: true})
+	if got := ids(res.Work); !reflect.DeepEqual(got, []string{"d", "c"}) {
+		t.Fatalf("reversed window listed %v, want [d c]", got)
+	}
+	res = list(window, &queryv1beta1.PageRequest{Key: res.Pagination.GetNextKey(), Limit: 2, Reverse: true})
+	if got := ids(res.Work); !reflect.DeepEqual(got, []string{"b"}) || res.Pagination.GetNextKey() != nil {
+		t.Fatalf("next reversed page listed %v with next key %x, want [b] and no next key", got, res.Pagination.GetNextKey())
+	}
+
+	// A page key from outside the window cannot widen it
+	res = list(&types.WorkFilter{MinSubmittedAt: 10}, &queryv1beta1.PageRequest{Limit: 4})
+	_, err := qs.ListWork(ctx, &types.QueryListWorkRequest{Filter: window, Pagination: &queryv1beta1.PageRequest{Key: res.Pagination.GetNextKey()}})
+	if err == nil {
+		t.Fatal("paging a height window from a key above it succeeded")
+	}
+}
+
+func TestWorkBySubmitter(t *testing.T) {
+	qs, ctx := listedWork(t)
+	bySubmitter := func(submitter string, page *queryv1beta1.PageRequest) *types.QueryWorkBySubmitterResponse {
+		t.Helper()
+		res, err := qs.WorkBySubmitter(ctx, &types.QueryWorkBySubmitterRequest{Submitter: submitter, Pagination: page})
+		if err != nil {
+			t.Fatalf("failed to query work by submitter: %v", err)
+		}
+		return res
+	}
+
+	if got := ids(bySubmitter(testAddr("alice"), nil).Work); !reflect.DeepEqual(got, []string{"a", "d", "e"}) {
+		t.Fatalf("alice submitted %v, want [a d e]", got)
+	}
+	if got := bySubmitter(testAddr("carol"), nil).Work; len(got) != 0 {
+		t.Fatalf("carol submitted %v, want nothing", ids(got))
+	}
+
+	// The submitter's work is paged by key, offset and in reverse
+	first := bySubmitter(testAddr("alice"), &queryv1beta1.PageRequest{Limit: 2, CountTotal: true})
+	if got := ids(first.Work); !reflect.DeepEqual(got, []string{"a", "d"}) || first.Pagination.GetTotal() != 3 {
+		t.Fatalf("first page holds %v of %d, want [a d] of 3", got, first.Pagination.GetTotal())
+	}
+	next := bySubmitter(testAddr("alice"), &queryv1beta1.PageRequest{Key: first.Pagination.GetNextKey(), Limit: 2})
+	if got := ids(next.Work); !reflect.DeepEqual(got, []string{"e"}) || next.Pagination.GetNextKey() != nil {
+		t.Fatalf("next page holds %v with next key %x, want [e] and no next key", got, next.Pagination.GetNextKey())
+	}
+	if got := ids(bySubmitter(testAddr("alice"), &queryv1beta1.PageRequest{Offset: 1, Limit: 1}).Work); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Fatalf("offset page holds %v, want [d]", got)
+	}
+	if got := ids(bySubmitter(testAddr("alice"), &queryv1beta1.PageRequest{Limit: 2, Reverse: true}).Work); !reflect.DeepEqual(got, []string{"e", "d"}) {
+		t.Fatalf("reversed page holds %v, want [e d]", got)
+	}
+
+	// Submitters must be valid addresses
+	for _, submitter := range []string{"", "alice", "cosmos1notbech32", testAddr("alice") + "x"} {
+		_, err := qs.WorkBySubmitter(ctx, &types.QueryWorkBySubmitterRequest{Submitter: submitter})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("querying submitter %q returned %v, want %v", submitter, err, codes.InvalidArgument)
+		}
+	}
+}
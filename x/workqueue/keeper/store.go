@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protoMessage is a pointer to a generated protobuf message
+type protoMessage[T any] interface {
+	*T
+	gogoproto.Message
+	ProtoReflect() protoreflect.Message
+}
+
+// protoValue is a collections value codec storing pointers to protobuf
+// messages with the module codec, so values keep the encoding they had before
+// the module moved to collections
+type protoValue[T any, PT protoMessage[T]] struct {
+	cdc codec.BinaryCodec
+}
+
+// newProtoValue returns the value codec for messages of type T
+func newProtoValue[T any, PT protoMessage[T]](cdc codec.BinaryCodec) collcodec.ValueCodec[PT] {
+	return protoValue[T, PT]{cdc: cdc}
+}
+
+func (v protoValue[T, PT]) Encode(value PT) ([]byte, error) {
+	return v.cdc.Marshal(value)
+}
+
+func (v protoValue[T, PT]) Decode(b []byte) (PT, error) {
+	value := PT(new(T))
+	if err := v.cdc.Unmarshal(b, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (v protoValue[T, PT]) EncodeJSON(value PT) ([]byte, error) {
+	return protojson.Marshal(value)
+}
+
+func (v protoValue[T, PT]) DecodeJSON(b []byte) (PT, error) {
+	value := PT(new(T))
+	if err := protojson.Unmarshal(b, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (v protoValue[T, PT]) Stringify(value PT) string {
+	return value.String()
+}
+
+func (v protoValue[T, PT]) ValueType() string {
+	return string(PT(new(T)).ProtoReflect().Descriptor().FullName())
+}
+
+// get reads the value stored under a key, reporting whether one was found.
+// Any other error means the store is corrupt and panics, as decoding a
+// corrupt value does.
+func get[K, V any](ctx context.Context, m interface {
+	Get(ctx context.Context, key K) (V, error)
+}, key K,
+) (V, bool) {
+	value, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		var zero V
+		return zero, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return value, true
+}
+
+// walk iterates over the values of a map in key order within the optional
+// range. Iteration stops when the callback returns true.
+func walk[K, V any](ctx context.Context, m collections.Map[K, V], ranger collections.Ranger[K], cb func(value V) (stop bool)) {
+	err := m.Walk(ctx, ranger, func(_ K, value V) (bool, error) {
+		return cb(value), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// must panics on a store write error, which only a failing store or an
+// unencodable key or value can cause
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetQuorumRule returns the quorum rule for a work type. The rule carried by
+// the work type's definition takes precedence over an explicitly set rule,
+// and the default rule from the module parameters applies when neither is set.
+func (k Keeper) GetQuorumRule(ctx sdk.Context, workType string) *types.QuorumRule {
+	if def, found := k.GetWorkTypeDefinition(ctx, workType); found && def.QuorumRule != nil {
+		return def.QuorumRule
+	}
+
+	rule, found := get(ctx, k.quorumRules, workType)
+	if !found {
+		return k.GetParams(ctx).QuorumRuleFor(workType)
+	}
+	return rule
+}
+
+// SetQuorumRule stores the quorum rule for a work type
+func (k Keeper) SetQuorumRule(ctx sdk.Context, rule *types.QuorumRule) {
+	must(k.quorumRules.Set(ctx, rule.WorkType, rule))
+}
+
+// IterateQuorumRules iterates over all explicitly set quorum rules
+func (k Keeper) IterateQuorumRules(ctx sdk.Context, cb func(rule *types.QuorumRule) (stop bool)) {
+	walk(ctx, k.quorumRules, nil, cb)
+}
+
+// GetWorkVote retrieves a validator's vote on a work unit
+func (k Keeper) GetWorkVote(ctx sdk.Context, workID, validatorAddr string) (*types.WorkVote, bool) {
+	return get(ctx, k.votes, collections.Join(workID, validatorAddr))
+}
+
+// SetWorkVote stores a validator's vote on a work unit
+func (k Keeper) SetWorkVote(ctx sdk.Context, vote *types.WorkVote) {
+	must(k.votes.Set(ctx, collections.Join(vote.WorkId, vote.Validator), vote))
+}
+
+// GetWorkVotes returns all votes cast on a work unit, ordered by validator
+func (k Keeper) GetWorkVotes(ctx sdk.Context, workID string) []*types.WorkVote {
+	var votes []*types.WorkVote
+	walk(ctx, k.votes, collections.NewPrefixedPairRange[string, string](workID), func(vote *types.WorkVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return votes
+}
+
+// IterateWorkVotes iterates over all votes, ordered by work unit then
+// validator
+func (k Keeper) IterateWorkVotes(ctx sdk.Context, cb func(vote *types.WorkVote) (stop bool)) {
+	walk(ctx, k.votes, nil, cb)
+}
+
+// GetVoteTally tallies the votes cast on a work unit against its quorum rule
+func (k Keeper) GetVoteTally(ctx sdk.Context, work *types.WorkUnit) *types.VoteTally {
+	return types.NewVoteTally(k.GetWorkVotes(ctx, work.Id), k.GetQuorumRule(ctx, work.Type))
+}
+
+// recordVote stores a vote in the work unit's current round: the re-validation
+// round while a challenge is active, the original round otherwise
+func (k Keeper) recordVote(ctx sdk.Context, vote *types.WorkVote) {
+	if _, active := k.activeChallenge(ctx, vote.WorkId); active {
+		k.SetChallengeVote(ctx, vote)
+		return
+	}
+	k.SetWorkVote(ctx, vote)
+}
+
+// tallyVotes releases the lease on a work unit after a vote and finalizes it
+// once enough votes are in. Work short of quorum returns to its queue so that
+// another validator can claim it.
+func (k Keeper) tallyVotes(ctx sdk.Context, work *types.WorkUnit) error {
+	if challenge, active := k.activeChallenge(ctx, work.Id); active {
+		return k.tallyChallenge(ctx, work, challenge)
+	}
+
+	votes := k.GetWorkVotes(ctx, work.Id)
+	tally := types.NewVoteTally(votes, k.GetQuorumRule(ctx, work.Type))
+
+	work.ClaimedBy = ""
+	work.LeaseExpiresAt = 0
+
+	if !tally.Reached() {
+		work.Status = types.WorkStatusPending
+		k.SetWork(ctx, work)
+		return nil
+	}
+
+	applyOutcome(ctx, work, votes, tally)
+	if work.Status == types.WorkStatusValidated {
+		k.IncrementTotalValidated(ctx)
+	} else {
+		k.IncrementTotalRejected(ctx)
+	}
+
+	if err := k.slashDissenters(ctx, votes, tally); err != nil {
+		return err
+	}
+
+	k.SetWork(ctx, work)
+	k.scheduleBounty(ctx, work)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWorkFinalized{
+		WorkId:       work.Id,
+		Status:       work.Status,
+		ValidVotes:   tally.ValidVotes,
+		InvalidVotes: tally.InvalidVotes,
+		Confidence:   work.Confidence,
+	}); err != nil {
+		return err
+	}
+
+	return k.afterWorkFinalized(ctx, work)
+}
+
+// applyOutcome sets the finalized status and confidence of a work unit from a
+// tally that reached quorum. The most confident vote on the winning side is
+// recorded as the work's validator and proof.
+func applyOutcome(ctx sdk.Context, work *types.WorkUnit, votes []*types.WorkVote, tally *types.VoteTally) {
+	accepted := tally.Accepted()
+	if accepted {
+		work.Status = types.WorkStatusValidated
+		work.Confidence = tally.AverageValidConfidence
+	} else {
+		work.Status = types.WorkStatusRejected
+		work.Confidence = tally.AverageInvalidConfidence
+	}
+
+	var representative *types.WorkVote
+	for _, vote := range votes {
+		if vote.Valid == accepted && (representative == nil || vote.Confidence > representative.Confidence) {
+			representative = vote
+		}
+	}
+	if representative != nil {
+		work.Validator = representative.Validator
+		work.Proof = representative.Proof
+	}
+	work.ValidatedAt = ctx.BlockHeight()
+}
+
+// afterWorkFinalized calls the hooks for a work unit that has reached a final
+// status
+func (k Keeper) afterWorkFinalized(ctx sdk.Context, work *types.WorkUnit) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	switch work.Status {
+	case types.WorkStatusValidated:
+		return k.hooks.AfterWorkValidated(ctx, work)
+	case types.WorkStatusRejected:
+		return k.hooks.AfterWorkRejected(ctx, work)
+	case types.WorkStatusExpired:
+		return k.hooks.AfterWorkExpired(ctx, work)
+	}
+	return nil
+}
+
+// afterWorkOverturned calls the hooks for a work unit whose finalized status
+// a challenge has reversed
+func (k Keeper) afterWorkOverturned(ctx sdk.Context, work *types.WorkUnit, originalStatus string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterWorkOverturned(ctx, work, originalStatus)
+}
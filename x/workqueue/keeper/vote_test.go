@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maco144/pickle/x/workqueue/keeper"
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestWorkFinalizesAtQuorum(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice", "bob", "carol")
+	alice, bob, carol := testAddr("alice"), testAddr("bob"), testAddr("carol")
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 3, RequiredAgreement: 2})
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+
+	// Work short of quorum returns to the queue for the next validator
+	castVote(t, k, ctx, workID, alice, true)
+	if work, _ := k.GetWork(ctx, workID); work.Status != types.WorkStatusPending || work.ClaimedBy != "" {
+		t.Fatalf("work with one of three votes is %s leased to %q, want pending", work.Status, work.ClaimedBy)
+	}
+	if _, err := k.ClaimWork(ctx, workID, alice); !errors.Is(err, types.ErrAlreadyVoted) {
+		t.Fatalf("claiming work again after voting returned %v, want %v", err, types.ErrAlreadyVoted)
+	}
+
+	castVote(t, k, ctx, workID, bob, false)
+	if _, err := k.ClaimWork(ctx, workID, carol); err != nil {
+		t.Fatalf("failed to claim work: %v", err)
+	}
+	if err := k.ValidateWork(ctx, workID, carol, true, 70, "other proof"); err != nil {
+		t.Fatalf("failed to vote on work: %v", err)
+	}
+
+	// The most confident vote on the winning side represents the work
+	work, _ := k.GetWork(ctx, workID)
+	if work.Status != types.WorkStatusValidated || work.Validator != alice || work.Proof != "proof" || work.Confidence != 80 {
+		t.Fatalf("work finalized %s by %q with proof %q at confidence %d, want validated by alice with proof at 80",
+			work.Status, work.Validator, work.Proof, work.Confidence)
+	}
+	if total := k.GetTotalWorkValidated(ctx); total != 1 {
+		t.Fatalf("counted %d validated units, want 1", total)
+	}
+}
+
+func TestWorkVotesQuery(t *testing.T) {
+	k, ctx, bank := newBankedKeeper()
+	bondValidators(t, k, ctx, bank, "alice", "bob")
+	k.SetQuorumRule(ctx, &types.QuorumRule{WorkType: types.WorkTypeCrypto, RequiredVotes: 2, RequiredAgreement: 2})
+	workID := submitWork(t, k, ctx, `{"block":1}`)
+	qs := keeper.NewQueryServerImpl(k)
+
+	res, err := qs.WorkVotes(ctx, &types.QueryWorkVotesRequest{WorkId: workID})
+	if err != nil {
+		t.Fatalf("failed to query votes: %v", err)
+	}
+	if len(res.Votes) != 0 || res.Tally.Reached() || res.Tally.Rule.RequiredVotes != 2 {
+		t.Fatalf("unvoted work has %d votes under a %v tally, want none under the 2 vote rule", len(res.Votes), res.Tally)
+	}
+
+	// Votes are listed by validator and tallied against the work type's rule
+	castVote(t, k, ctx, workID, testAddr("bob"), true)
+	castVote(t, k, ctx, workID, testAddr("alice"), false)
+	res, err = qs.WorkVotes(ctx, &types.QueryWorkVotesRequest{WorkId: workID})
+	if err != nil {
+		t.Fatalf("failed to query votes: %v", err)
+	}
+	var voters []string
+	for _, vote := range res.Votes {
+		voters = append(voters, vote.Validator)
+	}
+	if want := []string{testAddr("alice"), testAddr("bob")}; !reflect.DeepEqual(voters, want) {
+		t.Fatalf("votes are from %v, want %v", voters, want)
+	}
+	if res.Tally.ValidVotes != 1 || res.Tally.InvalidVotes != 1 || !res.Tally.Reached() || res.Tally.Accepted() {
+		t.Fatalf("tally is %v, want one vote each way reaching quorum without agreement", res.Tally)
+	}
+
+	if _, err := qs.WorkVotes(ctx, &types.QueryWorkVotesRequest{WorkId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("querying votes on missing work returned %v, want %v", err, codes.NotFound)
+	}
+	if _, err := qs.WorkVotes(ctx, nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("querying votes without a request returned %v, want %v", err, codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// GetWorkTypeDefinition retrieves a registered work type definition
+func (k Keeper) GetWorkTypeDefinition(ctx sdk.Context, name string) (*types.WorkTypeDefinition, bool) {
+	return get(ctx, k.workTypes, name)
+}
+
+// SetWorkTypeDefinition validates and stores a work type definition,
+// replacing any existing definition of the same name
+func (k Keeper) SetWorkTypeDefinition(ctx sdk.Context, def *types.WorkTypeDefinition) error {
+	if err := def.Validate(); err != nil {
+		return err
+	}
+
+	return k.workTypes.Set(ctx, def.Name, def)
+}
+
+// IterateWorkTypeDefinitions iterates over all registered work types in name
+// order
+func (k Keeper) IterateWorkTypeDefinitions(ctx sdk.Context, cb func(def *types.WorkTypeDefinition) (stop bool)) {
+	walk(ctx, k.workTypes, nil, cb)
+}
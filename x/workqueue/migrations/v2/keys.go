@@ -0,0 +1,285 @@
+// Package v2 holds the store layout of consensus version 2 of the workqueue
+// module and the migration to it from version 1. The layout is frozen here so
+// later migrations can read it after the module has moved on.
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+var (
+	// KeyPrefixWorkUnit is the prefix for work units
+	KeyPrefixWorkUnit = []byte{0x01}
+
+	// KeyPrefixValidatorStats is the prefix for validator statistics
+	KeyPrefixValidatorStats = []byte{0x02}
+
+	// KeyWorkQueue was reserved for the work queue state and never written
+	KeyWorkQueue = []byte{0x03}
+
+	// KeyPrefixWorkByStatus is the prefix for the status -> work ID index
+	KeyPrefixWorkByStatus = []byte{0x04}
+
+	// KeyPrefixWorkByType is the prefix for the work type -> work ID index
+	KeyPrefixWorkByType = []byte{0x05}
+
+	// KeyPrefixWorkBySubmittedAt is the prefix for the submitted height -> work ID index
+	KeyPrefixWorkBySubmittedAt = []byte{0x06}
+
+	// KeyPrefixLeaseExpiry is the prefix for the lease expiry height -> work ID index
+	KeyPrefixLeaseExpiry = []byte{0x07}
+
+	// KeyPrefixWorkBySubmitter is the prefix for the submitter -> work ID index
+	KeyPrefixWorkBySubmitter = []byte{0x08}
+
+	// KeyPrefixQuorumRule is the prefix for per work type quorum rules
+	KeyPrefixQuorumRule = []byte{0x09}
+
+	// KeyPrefixWorkVote is the prefix for validator votes on work units
+	KeyPrefixWorkVote = []byte{0x0A}
+
+	// KeyPrefixChallenge is the prefix for challenges raised against work units
+	KeyPrefixChallenge = []byte{0x0B}
+
+	// KeyPrefixChallengeVote is the prefix for re-validation votes on
+	// challenged work units
+	KeyPrefixChallengeVote = []byte{0x0C}
+
+	// KeyPrefixValidatorBond is the prefix for validator bonds
+	KeyPrefixValidatorBond = []byte{0x0D}
+
+	// KeyPrefixUnbonding is the prefix for the completion height -> unbonding
+	// entry queue
+	KeyPrefixUnbonding = []byte{0x0E}
+
+	// KeyPrefixBounty is the prefix for bounties attached to work units
+	KeyPrefixBounty = []byte{0x0F}
+
+	// KeyPrefixWorkExpiry is the prefix for the expiry height -> pending work
+	// ID index
+	KeyPrefixWorkExpiry = []byte{0x10}
+
+	// KeyParams stores the module parameters
+	KeyParams = []byte{0x11}
+
+	// KeyPrefixWorkType is the prefix for registered work type definitions
+	KeyPrefixWorkType = []byte{0x12}
+
+	// KeyPrefixPendingByPriority is the prefix for the scheduled height ->
+	// pending work ID index, served in ascending order
+	KeyPrefixPendingByPriority = []byte{0x13}
+
+	// KeyPrefixValidationCommit is the prefix for unrevealed validation
+	// commitments, keyed by work ID then validator
+	KeyPrefixValidationCommit = []byte{0x14}
+
+	// KeyPrefixRevealExpiry is the prefix for the reveal deadline -> revealing
+	// work ID index
+	KeyPrefixRevealExpiry = []byte{0x15}
+
+	// KeyTotalSubmitted stores the number of submitted work units
+	KeyTotalSubmitted = []byte("total_submitted")
+
+	// KeyTotalValidated stores the number of validated work units
+	KeyTotalValidated = []byte("total_validated")
+
+	// KeyTotalRejected stores the number of rejected work units
+	KeyTotalRejected = []byte("total_rejected")
+)
+
+// MaxIndexedFieldLength is the maximum length of a string field used as an
+// index key component, bounded by its one-byte length prefix.
+const MaxIndexedFieldLength = 255
+
+// MaxWorkIDLength is the maximum length of an indexed work ID
+const MaxWorkIDLength = 128
+
+// WorkUnitKey returns the key for a work unit
+func WorkUnitKey(workID string) []byte {
+	return append(cloneKey(KeyPrefixWorkUnit), []byte(workID)...)
+}
+
+// ValidatorStatsKey returns the key for validator statistics
+func ValidatorStatsKey(validatorAddr string) []byte {
+	return append(cloneKey(KeyPrefixValidatorStats), []byte(validatorAddr)...)
+}
+
+// WorkByStatusPrefix returns the index prefix for all work with the given status
+func WorkByStatusPrefix(status string) []byte {
+	return append(cloneKey(KeyPrefixWorkByStatus), lengthPrefix(status)...)
+}
+
+// WorkByStatusKey returns the index key for a work unit under its status
+func WorkByStatusKey(status, workID string) []byte {
+	return append(WorkByStatusPrefix(status), []byte(workID)...)
+}
+
+// WorkByTypePrefix returns the index prefix for all work of the given type
+func WorkByTypePrefix(workType string) []byte {
+	return append(cloneKey(KeyPrefixWorkByType), lengthPrefix(workType)...)
+}
+
+// WorkByTypeKey returns the index key for a work unit under its type
+func WorkByTypeKey(workType, workID string) []byte {
+	return append(WorkByTypePrefix(workType), []byte(workID)...)
+}
+
+// WorkBySubmittedAtPrefix returns the index prefix for all work submitted at
+// the given block height
+func WorkBySubmittedAtPrefix(height int64) []byte {
+	return append(cloneKey(KeyPrefixWorkBySubmittedAt), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// WorkBySubmittedAtKey returns the index key for a work unit under its
+// submission height
+func WorkBySubmittedAtKey(height int64, workID string) []byte {
+	return append(WorkBySubmittedAtPrefix(height), []byte(workID)...)
+}
+
+// WorkBySubmitterPrefix returns the index prefix for all work submitted by an
+// address
+func WorkBySubmitterPrefix(submitter string) []byte {
+	return append(cloneKey(KeyPrefixWorkBySubmitter), lengthPrefix(submitter)...)
+}
+
+// WorkBySubmitterKey returns the index key for a work unit under its submitter
+func WorkBySubmitterKey(submitter, workID string) []byte {
+	return append(WorkBySubmitterPrefix(submitter), []byte(workID)...)
+}
+
+// LeaseExpiryKey returns the index key for a claimed work unit under the
+// height at which its lease expires
+func LeaseExpiryKey(height int64, workID string) []byte {
+	key := append(cloneKey(KeyPrefixLeaseExpiry), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(workID)...)
+}
+
+// WorkExpiryKey returns the index key for a pending work unit under the
+// height at which it expires
+func WorkExpiryKey(height int64, workID string) []byte {
+	key := append(cloneKey(KeyPrefixWorkExpiry), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(workID)...)
+}
+
+// PendingByPriorityKey returns the index key for a pending work unit under its
+// virtual submission height. The height may be negative, so its sign bit is
+// flipped to keep the big endian encoding in numeric order.
+func PendingByPriorityKey(scheduledAt int64, workID string) []byte {
+	key := append(cloneKey(KeyPrefixPendingByPriority), sdk.Uint64ToBigEndian(uint64(scheduledAt)^(1<<63))...)
+	return append(key, []byte(workID)...)
+}
+
+// QuorumRuleKey returns the key for a work type's quorum rule
+func QuorumRuleKey(workType string) []byte {
+	return append(cloneKey(KeyPrefixQuorumRule), []byte(workType)...)
+}
+
+// WorkVotesPrefix returns the prefix for all votes cast on a work unit
+func WorkVotesPrefix(workID string) []byte {
+	return append(cloneKey(KeyPrefixWorkVote), lengthPrefix(workID)...)
+}
+
+// WorkVoteKey returns the key for a validator's vote on a work unit
+func WorkVoteKey(workID, validatorAddr string) []byte {
+	return append(WorkVotesPrefix(workID), []byte(validatorAddr)...)
+}
+
+// ValidationCommitsPrefix returns the key prefix for all commitments on a
+// work unit
+func ValidationCommitsPrefix(workID string) []byte {
+	return append(cloneKey(KeyPrefixValidationCommit), lengthPrefix(workID)...)
+}
+
+// ValidationCommitKey returns the key for a validator's commitment on a work
+// unit
+func ValidationCommitKey(workID, validatorAddr string) []byte {
+	return append(ValidationCommitsPrefix(workID), []byte(validatorAddr)...)
+}
+
+// RevealExpiryKey returns the index key for a work unit in its reveal phase
+// under the last height at which verdicts can be revealed
+func RevealExpiryKey(height int64, workID string) []byte {
+	key := append(cloneKey(KeyPrefixRevealExpiry), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(workID)...)
+}
+
+// ChallengeKey returns the key for the challenge raised against a work unit
+func ChallengeKey(workID string) []byte {
+	return append(cloneKey(KeyPrefixChallenge), []byte(workID)...)
+}
+
+// ChallengeVotesPrefix returns the prefix for all re-validation votes cast on
+// a challenged work unit
+func ChallengeVotesPrefix(workID string) []byte {
+	return append(cloneKey(KeyPrefixChallengeVote), lengthPrefix(workID)...)
+}
+
+// ChallengeVoteKey returns the key for a validator's re-validation vote on a
+// challenged work unit
+func ChallengeVoteKey(workID, validatorAddr string) []byte {
+	return append(ChallengeVotesPrefix(workID), []byte(validatorAddr)...)
+}
+
+// BountyKey returns the key for the bounty attached to a work unit
+func BountyKey(workID string) []byte {
+	return append(cloneKey(KeyPrefixBounty), []byte(workID)...)
+}
+
+// WorkTypeKey returns the key for a work type definition
+func WorkTypeKey(name string) []byte {
+	return append(cloneKey(KeyPrefixWorkType), []byte(name)...)
+}
+
+// ValidatorBondKey returns the key for a validator's bond
+func ValidatorBondKey(validatorAddr string) []byte {
+	return append(cloneKey(KeyPrefixValidatorBond), []byte(validatorAddr)...)
+}
+
+// UnbondingKey returns the key for a validator's stake completing unbonding
+// at the given height
+func UnbondingKey(height int64, validatorAddr string) []byte {
+	key := append(cloneKey(KeyPrefixUnbonding), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(validatorAddr)...)
+}
+
+// lengthPrefix prepends a one-byte length to s so that index keys sharing a
+// string component cannot be confused with one another.
+func lengthPrefix(s string) []byte {
+	if len(s) > MaxIndexedFieldLength {
+		panic("index key component exceeds maximum length")
+	}
+	return append([]byte{byte(len(s))}, []byte(s)...)
+}
+
+// cloneKey returns a copy of a package-level prefix so appends never share
+// its backing array.
+func cloneKey(prefix []byte) []byte {
+	return append([]byte{}, prefix...)
+}
+
+// WorkIndexKeys returns the secondary index keys of a work unit
+func WorkIndexKeys(work *types.WorkUnit) [][]byte {
+	keys := [][]byte{
+		WorkByStatusKey(work.Status, work.Id),
+		WorkByTypeKey(work.Type, work.Id),
+		WorkBySubmittedAtKey(work.SubmittedAt, work.Id),
+	}
+	if work.Submitter != "" {
+		keys = append(keys, WorkBySubmitterKey(work.Submitter, work.Id))
+	}
+	if work.Status == types.WorkStatusValidating {
+		keys = append(keys, LeaseExpiryKey(work.LeaseExpiresAt, work.Id))
+	}
+	if work.Status == types.WorkStatusPending {
+		keys = append(keys,
+			WorkExpiryKey(work.ExpiresAt, work.Id),
+			PendingByPriorityKey(work.ScheduledAt, work.Id),
+		)
+	}
+	if work.Status == types.WorkStatusRevealing {
+		keys = append(keys, RevealExpiryKey(work.RevealEndsAt, work.Id))
+	}
+	return keys
+}
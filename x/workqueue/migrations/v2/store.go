@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// MigrateStore migrates the store from consensus version 1 to 2.
+//
+// Version 1 stored work units and validator stats only: no params, work type
+// registry, secondary indexes or votes, and work units without deadlines or
+// scheduling heights. The migration
+//   - stores the default params and registers the default work types when
+//     absent
+//   - gives pending work a full expiry period from the upgrade height and
+//     schedules it at its default priority
+//   - records the verdict that finalized each unit as its vote
+//   - rewrites every unit, building its secondary index entries
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	if bz := store.Get(KeyParams); bz != nil {
+		params = &types.Params{}
+		if err := cdc.Unmarshal(bz, params); err != nil {
+			return err
+		}
+	} else {
+		store.Set(KeyParams, cdc.MustMarshal(params))
+	}
+
+	registry := prefix.NewStore(store, KeyPrefixWorkType).Iterator(nil, nil)
+	registered := registry.Valid()
+	registry.Close()
+	if !registered {
+		for _, def := range types.DefaultWorkTypes() {
+			store.Set(WorkTypeKey(def.Name), cdc.MustMarshal(def))
+		}
+	}
+
+	// Collect the units first; rewriting them while iterating would walk
+	// the entries being written
+	var units []*types.WorkUnit
+	iterator := prefix.NewStore(store, KeyPrefixWorkUnit).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var work types.WorkUnit
+		if err := cdc.Unmarshal(iterator.Value(), &work); err != nil {
+			iterator.Close()
+			return err
+		}
+		units = append(units, &work)
+	}
+	iterator.Close()
+
+	for _, work := range units {
+		if len(work.Id) > MaxWorkIDLength || len(work.Type) > MaxIndexedFieldLength || len(work.Submitter) > MaxIndexedFieldLength {
+			return fmt.Errorf("work unit %s: id, type or submitter too long to index", work.Id)
+		}
+
+		// Entries left by an earlier run of the migration are rewritten
+		stale := WorkIndexKeys(work)
+
+		switch work.Status {
+		case types.WorkStatusPending, types.WorkStatusValidating:
+			// Version 1 had no leases, so validating work is pending
+			work.Status = types.WorkStatusPending
+			if work.ExpiresAt == 0 {
+				work.ExpiresAt = ctx.BlockHeight() + params.WorkExpiryBlocks
+			}
+			work.ScheduledAt = params.ScheduledAt(work.SubmittedAt, work.Priority)
+		case types.WorkStatusValidated, types.WorkStatusRejected:
+			voteKey := WorkVoteKey(work.Id, work.Validator)
+			if work.Validator != "" && !store.Has(voteKey) {
+				store.Set(voteKey, cdc.MustMarshal(&types.WorkVote{
+					WorkId:     work.Id,
+					Validator:  work.Validator,
+					Valid:      work.Status == types.WorkStatusValidated,
+					Confidence: work.Confidence,
+					Proof:      work.Proof,
+					VotedAt:    work.ValidatedAt,
+				}))
+			}
+		}
+
+		for _, key := range stale {
+			store.Delete(key)
+		}
+		store.Set(WorkUnitKey(work.Id), cdc.MustMarshal(work))
+		for _, key := range WorkIndexKeys(work) {
+			store.Set(key, []byte{})
+		}
+	}
+
+	return nil
+}
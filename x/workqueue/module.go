@@ -1,9 +1,12 @@
 package workqueue
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/core/appmodule"
+
 	"github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -19,9 +22,15 @@ import (
 	workqueuetypes "github.com/maco144/pickle/x/workqueue/types"
 )
 
+// FlagEnableInvariantsQuery is the node flag serving the CheckInvariants
+// query
+const FlagEnableInvariantsQuery = "workqueue.enable-invariants-query"
+
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the workqueue module.
@@ -35,9 +44,7 @@ func (AppModuleBasic) Name() string {
 }
 
 // RegisterLegacyAminoCodec registers the module's types on the LegacyAmino codec.
-func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	// TODO: Register legacy amino codec if needed
-}
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -47,7 +54,7 @@ func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 // DefaultGenesis returns default genesis state as raw bytes for the workqueue
 // module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(&workqueuetypes.GenesisState{})
+	return cdc.MustMarshalJSON(workqueuetypes.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation for the workqueue module.
@@ -56,6 +63,9 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", workqueuetypes.ModuleName, err)
 	}
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state:\n%w", workqueuetypes.ModuleName, err)
+	}
 	return nil
 }
 
@@ -71,7 +81,9 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the workqueue module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
-	// TODO: Register gRPC Gateway routes if needed
+	if err := workqueuetypes.RegisterQueryHandlerClient(context.Background(), mux, workqueuetypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // AppModule implements an application module for the workqueue module.
@@ -98,6 +110,14 @@ func (AppModule) IsOnePerModuleType() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	workqueuetypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	workqueuetypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(workqueuetypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", workqueuetypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(workqueuetypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", workqueuetypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the workqueue module. It returns
@@ -116,10 +136,22 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
-// RegisterInvariants registers the workqueue module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// TODO: Register invariants if needed
+// RegisterInvariants registers the workqueue module invariants. The app does
+// not wire x/crisis, so nothing asserts them on chain: they run only through
+// the CheckInvariants query of nodes that enable it.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// EndBlock returns the end blocker for the workqueue module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+}
+
+// AddModuleInitFlags adds the workqueue flags to the start command
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagEnableInvariantsQuery, false, "Serve the workqueue CheckInvariants query, which scans the whole workqueue store")
+}
@@ -3,15 +3,38 @@ package types
 import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// RegisterInterfaces registers the Msgs of the Msg service and their
+// responses. The generated types are not in the gogoproto registry that
+// msgservice.RegisterMsgServiceDesc reads, so both are listed here.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitWork{},
+		&MsgClaimWork{},
 		&MsgValidateWork{},
+		&MsgCommitValidation{},
+		&MsgRevealValidation{},
 		&MsgRejectWork{},
+		&MsgChallengeWork{},
+		&MsgBond{},
+		&MsgUnbond{},
+		&MsgUpdateParams{},
+		&MsgSetWorkTypeDefinition{},
 	)
 
-	msgservice.RegisterMsgServiceDesc(registry, &Msg_ServiceDesc)
+	registry.RegisterImplementations((*tx.MsgResponse)(nil),
+		&MsgSubmitWorkResponse{},
+		&MsgClaimWorkResponse{},
+		&MsgValidateWorkResponse{},
+		&MsgCommitValidationResponse{},
+		&MsgRevealValidationResponse{},
+		&MsgRejectWorkResponse{},
+		&MsgChallengeWorkResponse{},
+		&MsgBondResponse{},
+		&MsgUnbondResponse{},
+		&MsgUpdateParamsResponse{},
+		&MsgSetWorkTypeDefinitionResponse{},
+	)
 }
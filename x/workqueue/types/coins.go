@@ -0,0 +1,37 @@
+package types
+
+import (
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewProtoCoin converts an SDK coin into the coin carried by workqueue messages
+func NewProtoCoin(coin sdk.Coin) *basev1beta1.Coin {
+	return &basev1beta1.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}
+}
+
+// SDKCoin converts a coin carried by workqueue messages into a validated SDK
+// coin
+func SDKCoin(coin *basev1beta1.Coin) (sdk.Coin, error) {
+	if coin == nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "coin cannot be empty")
+	}
+
+	amount, ok := math.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %q", coin.Amount)
+	}
+
+	sdkCoin := sdk.Coin{Denom: coin.Denom, Amount: amount}
+	if err := sdkCoin.Validate(); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return sdkCoin, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// ValidationCommitment derives the commitment a validator makes to a verdict
+// before revealing it: the SHA-256 hash of the length-prefixed work ID,
+// validator address, proof and salt together with the verdict and
+// confidence. Binding the validator address means a commitment copied from
+// another validator can never be revealed.
+func ValidationCommitment(workID, validator string, valid bool, confidence uint32, proof string, salt []byte) []byte {
+	h := sha256.New()
+	writeField := func(b []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(b)))
+		h.Write(b)
+	}
+
+	writeField([]byte(workID))
+	writeField([]byte(validator))
+	if valid {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+	}
+	_ = binary.Write(h, binary.BigEndian, confidence)
+	writeField([]byte(proof))
+	writeField(salt)
+
+	return h.Sum(nil)
+}
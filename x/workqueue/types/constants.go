@@ -1,5 +1,12 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
 const (
 	// WorkStatusPending is the status for pending work
 	WorkStatusPending = "pending"
@@ -9,8 +16,155 @@ const (
 	WorkStatusValidated = "validated"
 	// WorkStatusRejected is the status for rejected work
 	WorkStatusRejected = "rejected"
+	// WorkStatusChallenged is the status for finalized work under challenge,
+	// waiting to be claimed for re-validation
+	WorkStatusChallenged = "challenged"
+	// WorkStatusExpired is the status for work that was not finalized before
+	// its deadline
+	WorkStatusExpired = "expired"
+	// WorkStatusRevealing is the status for work whose round of committed
+	// verdicts is full, waiting for the verdicts to be revealed
+	WorkStatusRevealing = "revealing"
+
+	// WorkTypeCrypto is the work type for crypto transactions and data
+	WorkTypeCrypto = "crypto"
+	// WorkTypeSupplyChain is the work type for supply chain provenance
+	WorkTypeSupplyChain = "supply_chain"
+	// WorkTypeMLData is the work type for ML dataset integrity
+	WorkTypeMLData = "ml_data"
+
+	// BountyStatusEscrowed is the status for a bounty held by the module
+	BountyStatusEscrowed = "escrowed"
+	// BountyStatusPaid is the status for a bounty paid to validators
+	BountyStatusPaid = "paid"
+	// BountyStatusRefunded is the status for a bounty returned to its submitter
+	BountyStatusRefunded = "refunded"
+
+	// DefaultMaxDataSize is the default maximum size in bytes of submitted
+	// work data
+	DefaultMaxDataSize = 1 << 20
+
+	// DefaultMaxPriority is the default highest priority work may be
+	// submitted with
+	DefaultMaxPriority = 10
+
+	// DefaultPriorityAgingBlocks is the default number of blocks pending work
+	// waits to gain the equivalent of one priority level
+	DefaultPriorityAgingBlocks = 100
+
+	// DefaultMaxAssignmentsPerBlock is the default number of pending units
+	// considered for assignment each block
+	DefaultMaxAssignmentsPerBlock = 10
+
+	// DefaultMaxValidatorLoad is the default number of leased units a
+	// validator may hold before it is no longer assigned work
+	DefaultMaxValidatorLoad = 5
+
+	// DefaultCommitRevealEnabled leaves commit-reveal validation off, so
+	// validators vote directly with MsgValidateWork and MsgRejectWork until
+	// governance enables it
+	DefaultCommitRevealEnabled = false
+
+	// DefaultCommitBlocks is the default number of blocks a round collects
+	// commitments after its first one before its reveal phase opens
+	DefaultCommitBlocks = 20
+
+	// DefaultRevealBlocks is the default number of blocks validators have to
+	// reveal their committed verdicts
+	DefaultRevealBlocks = 20
+
+	// MinCommitSaltLength is the minimum length in bytes of the salt a
+	// verdict is committed with, so the few possible verdicts cannot be
+	// guessed from the commitment
+	MinCommitSaltLength = 16
+
+	// DefaultLeaseBlocks is the number of blocks a claimed work unit stays
+	// leased to its validator before returning to pending
+	DefaultLeaseBlocks = 50
+
+	// DefaultWorkExpiryBlocks is the number of blocks after submission by
+	// which pending work must be finalized before it expires
+	DefaultWorkExpiryBlocks = 10_000
+
+	// DefaultBountyBurnFraction is the fraction of a bounty burned when its
+	// work is rejected; the rest is refunded to the submitter
+	DefaultBountyBurnFraction = "0.1"
+
+	// DefaultChallengeWindow is the number of blocks after finalization during
+	// which a work unit's outcome can be challenged, and after a challenge
+	// during which its re-validation round must reach quorum
+	DefaultChallengeWindow = 100
+
+	// DefaultBondDenom is the denomination bonds are posted in
+	DefaultBondDenom = "upickle"
+
+	// DefaultMinChallengeBond is the minimum bond required to challenge work
+	DefaultMinChallengeBond = 1_000_000
+
+	// DefaultMinValidatorBond is the minimum bond a validator must hold to
+	// claim and vote on work
+	DefaultMinValidatorBond = 10_000_000
+
+	// DefaultUnbondingBlocks is the number of blocks unbonding stake stays
+	// slashable before it is returned. It outlasts the challenge window so that
+	// a validator cannot unbond ahead of a dispute.
+	DefaultUnbondingBlocks = 1_000
+
+	// DefaultSlashFraction is the fraction of bonded and unbonding stake burned
+	// each time a validator is overturned
+	DefaultSlashFraction = "0.05"
+
+	// DefaultJailThreshold is the number of slashes after which a validator is
+	// jailed, and again for every further multiple
+	DefaultJailThreshold = 3
+
+	// DefaultJailBlocks is the number of blocks a jailed validator may not
+	// claim or vote on work
+	DefaultJailBlocks = 10_000
 )
 
+// WorkID derives the content-addressed ID of a work unit: the hex encoded
+// SHA-256 hash of its length-prefixed type followed by its data. Identical
+// submissions therefore map to the same ID and are rejected as duplicates.
+func WorkID(workType string, data []byte) string {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, uint64(len(workType)))
+	h.Write([]byte(workType))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidateClientWorkID checks a work ID chosen by the submitter. IDs in the
+// format of a content hash are reserved for the hash of the work itself, so a
+// client cannot take the ID that other work hashes to.
+func ValidateClientWorkID(id, workType string, data []byte) error {
+	if isContentWorkID(id) && id != WorkID(workType, data) {
+		return fmt.Errorf("%s has the format of a content hash but is not the hash of the work", id)
+	}
+	return nil
+}
+
+// isContentWorkID reports whether an ID has the format WorkID produces: 64
+// lowercase hex digits
+func isContentWorkID(id string) bool {
+	if len(id) != 2*sha256.Size {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFinal reports whether a work status is a verdict. A verdict is final
+// unless challenged within ChallengeWindow blocks of being reached, after
+// which re-validation can still overturn it.
+func IsFinal(status string) bool {
+	return status == WorkStatusValidated || status == WorkStatusRejected
+}
+
 // IncrementWorkType increments the work count for a specific type
 func (vs *ValidatorStats) IncrementWorkType(workType string) {
 	if vs.Specializations == nil {
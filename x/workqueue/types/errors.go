@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/workqueue module sentinel errors
+var (
+	ErrWorkNotFound              = errorsmod.Register(ModuleName, 2, "work unit not found")
+	ErrDuplicateWorkID           = errorsmod.Register(ModuleName, 3, "work unit already exists")
+	ErrInvalidWorkType           = errorsmod.Register(ModuleName, 4, "invalid work type")
+	ErrInvalidWorkID             = errorsmod.Register(ModuleName, 5, "invalid work id")
+	ErrInvalidConfidence         = errorsmod.Register(ModuleName, 6, "invalid confidence")
+	ErrWorkNotPending            = errorsmod.Register(ModuleName, 7, "work unit is not pending")
+	ErrWorkNotClaimed            = errorsmod.Register(ModuleName, 8, "work unit is not claimed")
+	ErrNotLeaseHolder            = errorsmod.Register(ModuleName, 9, "validator does not hold the lease")
+	ErrLeaseExpired              = errorsmod.Register(ModuleName, 10, "lease expired")
+	ErrInvalidSubmitter          = errorsmod.Register(ModuleName, 11, "invalid submitter")
+	ErrUnknownValidator          = errorsmod.Register(ModuleName, 12, "validator is not registered")
+	ErrAlreadyVoted              = errorsmod.Register(ModuleName, 13, "validator already voted on work unit")
+	ErrWorkNotFinal              = errorsmod.Register(ModuleName, 14, "work unit is not finalized")
+	ErrChallengeClosed           = errorsmod.Register(ModuleName, 15, "challenge window closed")
+	ErrAlreadyChallenged         = errorsmod.Register(ModuleName, 16, "work unit already challenged")
+	ErrInvalidBond               = errorsmod.Register(ModuleName, 17, "invalid bond")
+	ErrInsufficientBond          = errorsmod.Register(ModuleName, 18, "insufficient validator bond")
+	ErrValidatorJailed           = errorsmod.Register(ModuleName, 19, "validator is jailed")
+	ErrInvalidBounty             = errorsmod.Register(ModuleName, 20, "invalid bounty")
+	ErrInvalidAuthority          = errorsmod.Register(ModuleName, 21, "invalid authority")
+	ErrInvalidParams             = errorsmod.Register(ModuleName, 22, "invalid params")
+	ErrWorkTooLarge              = errorsmod.Register(ModuleName, 23, "work data too large")
+	ErrUnknownWorkType           = errorsmod.Register(ModuleName, 24, "unknown work type")
+	ErrWorkTypeDisabled          = errorsmod.Register(ModuleName, 25, "work type disabled")
+	ErrInvalidWorkTypeDefinition = errorsmod.Register(ModuleName, 26, "invalid work type definition")
+	ErrSchemaViolation           = errorsmod.Register(ModuleName, 27, "work data does not match schema")
+	ErrInvalidPriority           = errorsmod.Register(ModuleName, 28, "invalid priority")
+	ErrCommitRevealRequired      = errorsmod.Register(ModuleName, 29, "verdicts must be committed and revealed")
+	ErrCommitRevealDisabled      = errorsmod.Register(ModuleName, 30, "commit-reveal validation is disabled")
+	ErrCommitNotFound            = errorsmod.Register(ModuleName, 31, "validation commitment not found")
+	ErrInvalidCommitment         = errorsmod.Register(ModuleName, 32, "invalid validation commitment")
+	ErrNotRevealing              = errorsmod.Register(ModuleName, 33, "work unit is not in its reveal phase")
+)
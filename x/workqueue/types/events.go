@@ -1,15 +1,72 @@
 package types
 
-const (
-	EventTypeWorkSubmitted = "work_submitted"
-	EventTypeWorkValidated = "work_validated"
-	EventTypeWorkRejected  = "work_rejected"
-
-	AttributeKeyWorkID     = "work_id"
-	AttributeKeyWorkType   = "work_type"
-	AttributeKeyValidator  = "validator"
-	AttributeKeyStatus     = "status"
-	AttributeKeyConfidence = "confidence"
-	AttributeKeySubmittedAt = "submitted_at"
-	AttributeKeyReason     = "reason"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
+
+// eventTypePrefix prefixes the type of every typed workqueue event, which is
+// the full name of its message
+const eventTypePrefix = "pickle.workqueue.v1.Event"
+
+// IsWorkqueueEvent reports whether an ABCI event is a typed workqueue event
+func IsWorkqueueEvent(event abci.Event) bool {
+	return strings.HasPrefix(event.Type, eventTypePrefix)
+}
+
+// ParseEvent decodes a typed workqueue event, such as *EventWorkSubmitted,
+// from the ABCI event it was emitted as
+func ParseEvent(event abci.Event) (proto.Message, error) {
+	if !IsWorkqueueEvent(event) {
+		return nil, fmt.Errorf("%q is not a workqueue event", event.Type)
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(event.Type))
+	if err != nil {
+		return nil, fmt.Errorf("unknown workqueue event %q: %w", event.Type, err)
+	}
+
+	// Each field's attribute value is its JSON encoding. Other attributes,
+	// like the message index and block phase added by the SDK, are skipped.
+	descriptor := msgType.Descriptor().Fields()
+	fields := make(map[string]json.RawMessage, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if descriptor.ByName(protoreflect.Name(attr.Key)) == nil {
+			continue
+		}
+		fields[attr.Key] = json.RawMessage(attr.Value)
+	}
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %w", event.Type, err)
+	}
+
+	msg := msgType.New().Interface()
+	if err := protojson.Unmarshal(bz, msg); err != nil {
+		return nil, fmt.Errorf("event %s: %w", event.Type, err)
+	}
+	return msg, nil
+}
+
+// ParseEvents decodes the typed workqueue events among ABCI events in the
+// order they were emitted, skipping the events of other modules
+func ParseEvents(events []abci.Event) ([]proto.Message, error) {
+	var parsed []proto.Message
+	for _, event := range events {
+		if !IsWorkqueueEvent(event) {
+			continue
+		}
+		msg, err := ParseEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, msg)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,1468 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: workqueue/v1/events.proto
+
+package types
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventWorkSubmitted is emitted when a work unit is submitted
+type EventWorkSubmitted struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WorkId    string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	WorkType  string                 `protobuf:"bytes,2,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	Submitter string                 `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// SubmittedAt is the block height the work was submitted at
+	SubmittedAt int64 `protobuf:"varint,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// Priority is the scheduling priority requested by the submitter
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// ExpiresAt is the last block height at which the work can be finalized
+	ExpiresAt     int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkSubmitted) Reset() {
+	*x = EventWorkSubmitted{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkSubmitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkSubmitted) ProtoMessage() {}
+
+func (x *EventWorkSubmitted) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkSubmitted.ProtoReflect.Descriptor instead.
+func (*EventWorkSubmitted) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventWorkSubmitted) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkSubmitted) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+func (x *EventWorkSubmitted) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *EventWorkSubmitted) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *EventWorkSubmitted) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *EventWorkSubmitted) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// EventWorkClaimed is emitted when a validator leases pending work
+type EventWorkClaimed struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WorkId    string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Validator string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// LeaseExpiresAt is the block height at which the lease expires
+	LeaseExpiresAt int64 `protobuf:"varint,3,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventWorkClaimed) Reset() {
+	*x = EventWorkClaimed{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkClaimed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkClaimed) ProtoMessage() {}
+
+func (x *EventWorkClaimed) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkClaimed.ProtoReflect.Descriptor instead.
+func (*EventWorkClaimed) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventWorkClaimed) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkClaimed) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventWorkClaimed) GetLeaseExpiresAt() int64 {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return 0
+}
+
+// EventWorkAssigned is emitted when the end blocker leases pending work to a
+// validator
+type EventWorkAssigned struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WorkId    string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	WorkType  string                 `protobuf:"bytes,2,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	Validator string                 `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// LeaseExpiresAt is the block height at which the lease expires
+	LeaseExpiresAt int64 `protobuf:"varint,4,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventWorkAssigned) Reset() {
+	*x = EventWorkAssigned{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkAssigned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkAssigned) ProtoMessage() {}
+
+func (x *EventWorkAssigned) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkAssigned.ProtoReflect.Descriptor instead.
+func (*EventWorkAssigned) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventWorkAssigned) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkAssigned) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+func (x *EventWorkAssigned) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventWorkAssigned) GetLeaseExpiresAt() int64 {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return 0
+}
+
+// EventWorkLeaseExpired is emitted when a lease expires without a verdict and
+// the work returns to its queue
+type EventWorkLeaseExpired struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	WorkId string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Validator is the former lease holder
+	Validator     string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkLeaseExpired) Reset() {
+	*x = EventWorkLeaseExpired{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkLeaseExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkLeaseExpired) ProtoMessage() {}
+
+func (x *EventWorkLeaseExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkLeaseExpired.ProtoReflect.Descriptor instead.
+func (*EventWorkLeaseExpired) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventWorkLeaseExpired) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkLeaseExpired) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// EventWorkValidated is emitted when a validator's verdict on a work unit is
+// recorded as a vote, whether submitted directly or revealed
+type EventWorkValidated struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WorkId    string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Validator string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Valid is the verdict
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Confidence is the validator's confidence in the verdict (0-100)
+	Confidence    uint32 `protobuf:"varint,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkValidated) Reset() {
+	*x = EventWorkValidated{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkValidated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkValidated) ProtoMessage() {}
+
+func (x *EventWorkValidated) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkValidated.ProtoReflect.Descriptor instead.
+func (*EventWorkValidated) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventWorkValidated) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkValidated) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventWorkValidated) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *EventWorkValidated) GetConfidence() uint32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// EventWorkRejected is emitted when a validator rejects a work unit with
+// MsgRejectWork
+type EventWorkRejected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Validator     string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkRejected) Reset() {
+	*x = EventWorkRejected{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkRejected) ProtoMessage() {}
+
+func (x *EventWorkRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkRejected.ProtoReflect.Descriptor instead.
+func (*EventWorkRejected) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventWorkRejected) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkRejected) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventWorkRejected) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventValidationCommitted is emitted when a lease holder commits a verdict
+type EventValidationCommitted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Validator     string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidationCommitted) Reset() {
+	*x = EventValidationCommitted{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidationCommitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidationCommitted) ProtoMessage() {}
+
+func (x *EventValidationCommitted) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidationCommitted.ProtoReflect.Descriptor instead.
+func (*EventValidationCommitted) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventValidationCommitted) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventValidationCommitted) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// EventWorkRevealStarted is emitted when a work unit's commitments fill its
+// quorum or its commit window closes, and its reveal phase begins
+type EventWorkRevealStarted struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	WorkId string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// RevealEndsAt is the last block height at which verdicts can be revealed
+	RevealEndsAt  int64 `protobuf:"varint,2,opt,name=reveal_ends_at,json=revealEndsAt,proto3" json:"reveal_ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkRevealStarted) Reset() {
+	*x = EventWorkRevealStarted{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkRevealStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkRevealStarted) ProtoMessage() {}
+
+func (x *EventWorkRevealStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkRevealStarted.ProtoReflect.Descriptor instead.
+func (*EventWorkRevealStarted) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventWorkRevealStarted) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkRevealStarted) GetRevealEndsAt() int64 {
+	if x != nil {
+		return x.RevealEndsAt
+	}
+	return 0
+}
+
+// EventValidationUnrevealed is emitted when a validator is slashed for not
+// revealing its commitment before the reveal phase ended
+type EventValidationUnrevealed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Validator     string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidationUnrevealed) Reset() {
+	*x = EventValidationUnrevealed{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidationUnrevealed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidationUnrevealed) ProtoMessage() {}
+
+func (x *EventValidationUnrevealed) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidationUnrevealed.ProtoReflect.Descriptor instead.
+func (*EventValidationUnrevealed) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventValidationUnrevealed) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventValidationUnrevealed) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// EventWorkFinalized is emitted when a work unit's votes reach quorum and its
+// outcome is decided
+type EventWorkFinalized struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	WorkId string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Status is the outcome, validated or rejected
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ValidVotes   uint32 `protobuf:"varint,3,opt,name=valid_votes,json=validVotes,proto3" json:"valid_votes,omitempty"`
+	InvalidVotes uint32 `protobuf:"varint,4,opt,name=invalid_votes,json=invalidVotes,proto3" json:"invalid_votes,omitempty"`
+	// Confidence is the average confidence of the votes for the outcome
+	Confidence    uint32 `protobuf:"varint,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkFinalized) Reset() {
+	*x = EventWorkFinalized{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkFinalized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkFinalized) ProtoMessage() {}
+
+func (x *EventWorkFinalized) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkFinalized.ProtoReflect.Descriptor instead.
+func (*EventWorkFinalized) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventWorkFinalized) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkFinalized) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventWorkFinalized) GetValidVotes() uint32 {
+	if x != nil {
+		return x.ValidVotes
+	}
+	return 0
+}
+
+func (x *EventWorkFinalized) GetInvalidVotes() uint32 {
+	if x != nil {
+		return x.InvalidVotes
+	}
+	return 0
+}
+
+func (x *EventWorkFinalized) GetConfidence() uint32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// EventWorkExpired is emitted when pending work passes its deadline
+type EventWorkExpired struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	WorkType      string                 `protobuf:"bytes,2,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkExpired) Reset() {
+	*x = EventWorkExpired{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkExpired) ProtoMessage() {}
+
+func (x *EventWorkExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkExpired.ProtoReflect.Descriptor instead.
+func (*EventWorkExpired) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventWorkExpired) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkExpired) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+// EventBountySettled is emitted when a work unit's bounty is paid out or
+// refunded
+type EventBountySettled struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	WorkId string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Status is the bounty status after settlement, paid or refunded
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Amount is the amount paid to the validators or refunded to the submitter
+	Amount *v1beta1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Burned is the amount burned from a refund
+	Burned        *v1beta1.Coin `protobuf:"bytes,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventBountySettled) Reset() {
+	*x = EventBountySettled{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventBountySettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBountySettled) ProtoMessage() {}
+
+func (x *EventBountySettled) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBountySettled.ProtoReflect.Descriptor instead.
+func (*EventBountySettled) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventBountySettled) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventBountySettled) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventBountySettled) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *EventBountySettled) GetBurned() *v1beta1.Coin {
+	if x != nil {
+		return x.Burned
+	}
+	return nil
+}
+
+// EventWorkChallenged is emitted when a finalized outcome is challenged
+type EventWorkChallenged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Challenger    string                 `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Bond          *v1beta1.Coin          `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventWorkChallenged) Reset() {
+	*x = EventWorkChallenged{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventWorkChallenged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWorkChallenged) ProtoMessage() {}
+
+func (x *EventWorkChallenged) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWorkChallenged.ProtoReflect.Descriptor instead.
+func (*EventWorkChallenged) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventWorkChallenged) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventWorkChallenged) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *EventWorkChallenged) GetBond() *v1beta1.Coin {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *EventWorkChallenged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventChallengeResolved is emitted when a challenge's re-validation round
+// reaches quorum
+type EventChallengeResolved struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkId     string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Challenger string                 `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// Status is the work unit's status after resolution
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Overturned reports whether the original outcome was reversed
+	Overturned    bool `protobuf:"varint,4,opt,name=overturned,proto3" json:"overturned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventChallengeResolved) Reset() {
+	*x = EventChallengeResolved{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventChallengeResolved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventChallengeResolved) ProtoMessage() {}
+
+func (x *EventChallengeResolved) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventChallengeResolved.ProtoReflect.Descriptor instead.
+func (*EventChallengeResolved) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventChallengeResolved) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventChallengeResolved) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *EventChallengeResolved) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventChallengeResolved) GetOverturned() bool {
+	if x != nil {
+		return x.Overturned
+	}
+	return false
+}
+
+// EventChallengeExpired is emitted when a challenge's re-validation round
+// misses its deadline without reaching quorum. The bond is refunded and the
+// disputed status restored.
+type EventChallengeExpired struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkId     string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Challenger string                 `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Bond       *v1beta1.Coin          `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	// Status is the restored status of the work unit
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventChallengeExpired) Reset() {
+	*x = EventChallengeExpired{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventChallengeExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventChallengeExpired) ProtoMessage() {}
+
+func (x *EventChallengeExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventChallengeExpired.ProtoReflect.Descriptor instead.
+func (*EventChallengeExpired) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventChallengeExpired) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *EventChallengeExpired) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *EventChallengeExpired) GetBond() *v1beta1.Coin {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *EventChallengeExpired) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// EventValidatorBonded is emitted when a validator bonds stake
+type EventValidatorBonded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Validator     string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount        *v1beta1.Coin          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidatorBonded) Reset() {
+	*x = EventValidatorBonded{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidatorBonded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorBonded) ProtoMessage() {}
+
+func (x *EventValidatorBonded) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidatorBonded.ProtoReflect.Descriptor instead.
+func (*EventValidatorBonded) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventValidatorBonded) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventValidatorBonded) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventValidatorUnbonding is emitted when a validator starts unbonding stake
+type EventValidatorUnbonding struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Validator string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount    *v1beta1.Coin          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// CompletionHeight is the block height at which the stake is returned
+	CompletionHeight int64 `protobuf:"varint,3,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EventValidatorUnbonding) Reset() {
+	*x = EventValidatorUnbonding{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidatorUnbonding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorUnbonding) ProtoMessage() {}
+
+func (x *EventValidatorUnbonding) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidatorUnbonding.ProtoReflect.Descriptor instead.
+func (*EventValidatorUnbonding) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventValidatorUnbonding) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventValidatorUnbonding) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *EventValidatorUnbonding) GetCompletionHeight() int64 {
+	if x != nil {
+		return x.CompletionHeight
+	}
+	return 0
+}
+
+// EventValidatorUnbonded is emitted when unbonding stake is returned to its
+// validator
+type EventValidatorUnbonded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Validator     string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount        *v1beta1.Coin          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidatorUnbonded) Reset() {
+	*x = EventValidatorUnbonded{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidatorUnbonded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorUnbonded) ProtoMessage() {}
+
+func (x *EventValidatorUnbonded) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidatorUnbonded.ProtoReflect.Descriptor instead.
+func (*EventValidatorUnbonded) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EventValidatorUnbonded) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventValidatorUnbonded) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventValidatorSlashed is emitted when a validator's stake is slashed
+type EventValidatorSlashed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Validator     string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount        *v1beta1.Coin          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidatorSlashed) Reset() {
+	*x = EventValidatorSlashed{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidatorSlashed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorSlashed) ProtoMessage() {}
+
+func (x *EventValidatorSlashed) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidatorSlashed.ProtoReflect.Descriptor instead.
+func (*EventValidatorSlashed) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EventValidatorSlashed) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventValidatorSlashed) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventValidatorJailed is emitted when a validator is jailed
+type EventValidatorJailed struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Validator string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// JailedUntil is the block height until which the validator is jailed
+	JailedUntil   int64 `protobuf:"varint,2,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventValidatorJailed) Reset() {
+	*x = EventValidatorJailed{}
+	mi := &file_workqueue_v1_events_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventValidatorJailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorJailed) ProtoMessage() {}
+
+func (x *EventValidatorJailed) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_events_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventValidatorJailed.ProtoReflect.Descriptor instead.
+func (*EventValidatorJailed) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EventValidatorJailed) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *EventValidatorJailed) GetJailedUntil() int64 {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return 0
+}
+
+var File_workqueue_v1_events_proto protoreflect.FileDescriptor
+
+const file_workqueue_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x19workqueue/v1/events.proto\x12\x13pickle.workqueue.v1\x1a\x1ecosmos/base/v1beta1/coin.proto\"\xc6\x01\n" +
+	"\x12EventWorkSubmitted\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1b\n" +
+	"\twork_type\x18\x02 \x01(\tR\bworkType\x12\x1c\n" +
+	"\tsubmitter\x18\x03 \x01(\tR\tsubmitter\x12!\n" +
+	"\fsubmitted_at\x18\x04 \x01(\x03R\vsubmittedAt\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\rR\bpriority\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"s\n" +
+	"\x10EventWorkClaimed\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12(\n" +
+	"\x10lease_expires_at\x18\x03 \x01(\x03R\x0eleaseExpiresAt\"\x91\x01\n" +
+	"\x11EventWorkAssigned\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1b\n" +
+	"\twork_type\x18\x02 \x01(\tR\bworkType\x12\x1c\n" +
+	"\tvalidator\x18\x03 \x01(\tR\tvalidator\x12(\n" +
+	"\x10lease_expires_at\x18\x04 \x01(\x03R\x0eleaseExpiresAt\"N\n" +
+	"\x15EventWorkLeaseExpired\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\"\x81\x01\n" +
+	"\x12EventWorkValidated\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\rR\n" +
+	"confidence\"b\n" +
+	"\x11EventWorkRejected\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"Q\n" +
+	"\x18EventValidationCommitted\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\"W\n" +
+	"\x16EventWorkRevealStarted\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12$\n" +
+	"\x0ereveal_ends_at\x18\x02 \x01(\x03R\frevealEndsAt\"R\n" +
+	"\x19EventValidationUnrevealed\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\"\xab\x01\n" +
+	"\x12EventWorkFinalized\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vvalid_votes\x18\x03 \x01(\rR\n" +
+	"validVotes\x12#\n" +
+	"\rinvalid_votes\x18\x04 \x01(\rR\finvalidVotes\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\rR\n" +
+	"confidence\"H\n" +
+	"\x10EventWorkExpired\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1b\n" +
+	"\twork_type\x18\x02 \x01(\tR\bworkType\"\xab\x01\n" +
+	"\x12EventBountySettled\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x121\n" +
+	"\x06amount\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\x121\n" +
+	"\x06burned\x18\x04 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06burned\"\x95\x01\n" +
+	"\x13EventWorkChallenged\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x02 \x01(\tR\n" +
+	"challenger\x12-\n" +
+	"\x04bond\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x04bond\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x89\x01\n" +
+	"\x16EventChallengeResolved\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x02 \x01(\tR\n" +
+	"challenger\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"overturned\x18\x04 \x01(\bR\n" +
+	"overturned\"\x97\x01\n" +
+	"\x15EventChallengeExpired\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x02 \x01(\tR\n" +
+	"challenger\x12-\n" +
+	"\x04bond\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x04bond\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"g\n" +
+	"\x14EventValidatorBonded\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\"\x97\x01\n" +
+	"\x17EventValidatorUnbonding\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\x12+\n" +
+	"\x11completion_height\x18\x03 \x01(\x03R\x10completionHeight\"i\n" +
+	"\x16EventValidatorUnbonded\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\"h\n" +
+	"\x15EventValidatorSlashed\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\"W\n" +
+	"\x14EventValidatorJailed\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x12!\n" +
+	"\fjailed_until\x18\x02 \x01(\x03R\vjailedUntilB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
+
+var (
+	file_workqueue_v1_events_proto_rawDescOnce sync.Once
+	file_workqueue_v1_events_proto_rawDescData []byte
+)
+
+func file_workqueue_v1_events_proto_rawDescGZIP() []byte {
+	file_workqueue_v1_events_proto_rawDescOnce.Do(func() {
+		file_workqueue_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_workqueue_v1_events_proto_rawDesc), len(file_workqueue_v1_events_proto_rawDesc)))
+	})
+	return file_workqueue_v1_events_proto_rawDescData
+}
+
+var file_workqueue_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_workqueue_v1_events_proto_goTypes = []any{
+	(*EventWorkSubmitted)(nil),        // 0: pickle.workqueue.v1.EventWorkSubmitted
+	(*EventWorkClaimed)(nil),          // 1: pickle.workqueue.v1.EventWorkClaimed
+	(*EventWorkAssigned)(nil),         // 2: pickle.workqueue.v1.EventWorkAssigned
+	(*EventWorkLeaseExpired)(nil),     // 3: pickle.workqueue.v1.EventWorkLeaseExpired
+	(*EventWorkValidated)(nil),        // 4: pickle.workqueue.v1.EventWorkValidated
+	(*EventWorkRejected)(nil),         // 5: pickle.workqueue.v1.EventWorkRejected
+	(*EventValidationCommitted)(nil),  // 6: pickle.workqueue.v1.EventValidationCommitted
+	(*EventWorkRevealStarted)(nil),    // 7: pickle.workqueue.v1.EventWorkRevealStarted
+	(*EventValidationUnrevealed)(nil), // 8: pickle.workqueue.v1.EventValidationUnrevealed
+	(*EventWorkFinalized)(nil),        // 9: pickle.workqueue.v1.EventWorkFinalized
+	(*EventWorkExpired)(nil),          // 10: pickle.workqueue.v1.EventWorkExpired
+	(*EventBountySettled)(nil),        // 11: pickle.workqueue.v1.EventBountySettled
+	(*EventWorkChallenged)(nil),       // 12: pickle.workqueue.v1.EventWorkChallenged
+	(*EventChallengeResolved)(nil),    // 13: pickle.workqueue.v1.EventChallengeResolved
+	(*EventChallengeExpired)(nil),     // 14: pickle.workqueue.v1.EventChallengeExpired
+	(*EventValidatorBonded)(nil),      // 15: pickle.workqueue.v1.EventValidatorBonded
+	(*EventValidatorUnbonding)(nil),   // 16: pickle.workqueue.v1.EventValidatorUnbonding
+	(*EventValidatorUnbonded)(nil),    // 17: pickle.workqueue.v1.EventValidatorUnbonded
+	(*EventValidatorSlashed)(nil),     // 18: pickle.workqueue.v1.EventValidatorSlashed
+	(*EventValidatorJailed)(nil),      // 19: pickle.workqueue.v1.EventValidatorJailed
+	(*v1beta1.Coin)(nil),              // 20: cosmos.base.v1beta1.Coin
+}
+var file_workqueue_v1_events_proto_depIdxs = []int32{
+	20, // 0: pickle.workqueue.v1.EventBountySettled.amount:type_name -> cosmos.base.v1beta1.Coin
+	20, // 1: pickle.workqueue.v1.EventBountySettled.burned:type_name -> cosmos.base.v1beta1.Coin
+	20, // 2: pickle.workqueue.v1.EventWorkChallenged.bond:type_name -> cosmos.base.v1beta1.Coin
+	20, // 3: pickle.workqueue.v1.EventChallengeExpired.bond:type_name -> cosmos.base.v1beta1.Coin
+	20, // 4: pickle.workqueue.v1.EventValidatorBonded.amount:type_name -> cosmos.base.v1beta1.Coin
+	20, // 5: pickle.workqueue.v1.EventValidatorUnbonding.amount:type_name -> cosmos.base.v1beta1.Coin
+	20, // 6: pickle.workqueue.v1.EventValidatorUnbonded.amount:type_name -> cosmos.base.v1beta1.Coin
+	20, // 7: pickle.workqueue.v1.EventValidatorSlashed.amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_workqueue_v1_events_proto_init() }
+func file_workqueue_v1_events_proto_init() {
+	if File_workqueue_v1_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workqueue_v1_events_proto_rawDesc), len(file_workqueue_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_workqueue_v1_events_proto_goTypes,
+		DependencyIndexes: file_workqueue_v1_events_proto_depIdxs,
+		MessageInfos:      file_workqueue_v1_events_proto_msgTypes,
+	}.Build()
+	File_workqueue_v1_events_proto = out.File
+	file_workqueue_v1_events_proto_goTypes = nil
+	file_workqueue_v1_events_proto_depIdxs = nil
+}
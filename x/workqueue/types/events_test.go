@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestParseEventsRoundTrip(t *testing.T) {
+	emitted := []proto.Message{
+		&types.EventWorkSubmitted{WorkId: "work", WorkType: types.WorkTypeCrypto, Submitter: "submitter", SubmittedAt: 10, Priority: 3, ExpiresAt: 110},
+		&types.EventBountySettled{WorkId: "work", Amount: &basev1beta1.Coin{Denom: types.DefaultBondDenom, Amount: "1000"}},
+		&types.EventChallengeResolved{WorkId: "work", Challenger: "challenger", Status: types.WorkStatusRejected, Overturned: true},
+	}
+
+	em := sdk.NewEventManager()
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", "1upickle")))
+	for _, event := range emitted {
+		if err := em.EmitTypedEvent(event.(gogoproto.Message)); err != nil {
+			t.Fatalf("failed to emit %T: %v", event, err)
+		}
+	}
+	// The SDK tags events with the message that emitted them
+	events := em.ABCIEvents()
+	for i := range events {
+		events[i].Attributes = append(events[i].Attributes, abci.EventAttribute{Key: "msg_index", Value: "0"})
+	}
+
+	// Events of other modules are skipped and the rest decode in order
+	parsed, err := types.ParseEvents(events)
+	if err != nil {
+		t.Fatalf("failed to parse events: %v", err)
+	}
+	if len(parsed) != len(emitted) {
+		t.Fatalf("parsed %d events, want %d", len(parsed), len(emitted))
+	}
+	for i, event := range parsed {
+		if !proto.Equal(event, emitted[i]) {
+			t.Fatalf("parsed event %d as %v, want %v", i, event, emitted[i])
+		}
+	}
+}
+
+func TestParseEventRejectsMalformedEvents(t *testing.T) {
+	for name, event := range map[string]abci.Event{
+		"other module": {Type: "transfer"},
+		"unknown type": {Type: "pickle.workqueue.v1.EventWorkVanished"},
+		"malformed attribute": {Type: "pickle.workqueue.v1.EventWorkSubmitted", Attributes: []abci.EventAttribute{
+			{Key: "work_id", Value: "work"},
+		}},
+		"mistyped attribute": {Type: "pickle.workqueue.v1.EventWorkSubmitted", Attributes: []abci.EventAttribute{
+			{Key: "submitted_at", Value: `"tall"`},
+		}},
+	} {
+		if _, err := types.ParseEvent(event); err == nil {
+			t.Fatalf("parsing an event of %s succeeded", name)
+		}
+	}
+
+	// One bad event fails the whole batch
+	events := []abci.Event{{Type: "pickle.workqueue.v1.EventWorkVanished"}}
+	if _, err := types.ParseEvents(events); err == nil {
+		t.Fatal("parsing a batch holding an unknown event succeeded")
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import "fmt"
+
+// Validate checks that the filter's fields are usable as index bounds.
+func (f *WorkFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if err := ValidateKeyComponent(f.Status, MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("status %w", err)
+	}
+	if err := ValidateKeyComponent(f.WorkType, MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("work type %w", err)
+	}
+	if err := ValidateKeyComponent(f.Submitter, MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("submitter %w", err)
+	}
+	if f.MinSubmittedAt < 0 || f.MaxSubmittedAt < 0 {
+		return fmt.Errorf("submitted height bounds cannot be negative")
+	}
+	if f.MaxSubmittedAt > 0 && f.MinSubmittedAt > f.MaxSubmittedAt {
+		return fmt.Errorf("min submitted height %d exceeds max submitted height %d", f.MinSubmittedAt, f.MaxSubmittedAt)
+	}
+	return nil
+}
+
+// Matches reports whether a work unit satisfies every set field of the filter.
+func (f *WorkFilter) Matches(work *WorkUnit) bool {
+	if f == nil {
+		return true
+	}
+	if f.Status != "" && work.Status != f.Status {
+		return false
+	}
+	if f.WorkType != "" && work.Type != f.WorkType {
+		return false
+	}
+	if f.Submitter != "" && work.Submitter != f.Submitter {
+		return false
+	}
+	if f.MinSubmittedAt > 0 && work.SubmittedAt < f.MinSubmittedAt {
+		return false
+	}
+	if f.MaxSubmittedAt > 0 && work.SubmittedAt > f.MaxSubmittedAt {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,244 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		WorkTypes: DefaultWorkTypes(),
+	}
+}
+
+// workStatuses is the set of statuses a stored work unit may have
+var workStatuses = map[string]bool{
+	WorkStatusPending:    true,
+	WorkStatusValidating: true,
+	WorkStatusRevealing:  true,
+	WorkStatusValidated:  true,
+	WorkStatusRejected:   true,
+	WorkStatusChallenged: true,
+	WorkStatusExpired:    true,
+}
+
+// Validate performs genesis state validation. Every problem found is
+// reported, joined into a single error.
+func (gs *GenesisState) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if gs.Params != nil {
+		if err := gs.Params.Validate(); err != nil {
+			fail("invalid params: %w", err)
+		}
+	}
+
+	seenTypes := make(map[string]bool)
+	for _, def := range gs.WorkTypes {
+		if err := def.Validate(); err != nil {
+			fail("invalid work type definition %s: %w", def.Name, err)
+		}
+		if seenTypes[def.Name] {
+			fail("duplicate work type definition %s", def.Name)
+		}
+		seenTypes[def.Name] = true
+	}
+
+	seenRules := make(map[string]bool)
+	for _, rule := range gs.QuorumRules {
+		if err := rule.Validate(); err != nil {
+			fail("invalid quorum rule for work type %s: %w", rule.WorkType, err)
+		}
+		if seenRules[rule.WorkType] {
+			fail("duplicate quorum rule for work type %s", rule.WorkType)
+		}
+		seenRules[rule.WorkType] = true
+	}
+
+	seenValidators := make(map[string]bool)
+	for _, stats := range gs.Validators {
+		if _, err := sdk.AccAddressFromBech32(stats.Address); err != nil {
+			fail("invalid validator stats address %q: %w", stats.Address, err)
+		}
+		if stats.AverageConfidence > 100 {
+			fail("validator %s: average confidence %d exceeds 100", stats.Address, stats.AverageConfidence)
+		}
+		if seenValidators[stats.Address] {
+			fail("duplicate stats for validator %s", stats.Address)
+		}
+		seenValidators[stats.Address] = true
+	}
+
+	seenBonds := make(map[string]bool)
+	for _, bond := range gs.Bonds {
+		if _, err := sdk.AccAddressFromBech32(bond.Validator); err != nil {
+			fail("invalid bond validator address %q: %w", bond.Validator, err)
+		}
+		if _, err := SDKCoin(bond.Amount); err != nil {
+			fail("invalid bond for validator %s: %w", bond.Validator, err)
+		}
+		if seenBonds[bond.Validator] {
+			fail("duplicate bond for validator %s", bond.Validator)
+		}
+		seenBonds[bond.Validator] = true
+	}
+
+	for _, entry := range gs.Unbonding {
+		if _, err := sdk.AccAddressFromBech32(entry.Validator); err != nil {
+			fail("invalid unbonding validator address %q: %w", entry.Validator, err)
+		}
+		if _, err := SDKCoin(entry.Amount); err != nil {
+			fail("invalid unbonding amount for validator %s: %w", entry.Validator, err)
+		}
+	}
+
+	// Unresolved challenges hold the finalized status a challenged unit is
+	// counted under
+	challenges := make(map[string]*Challenge)
+	for _, challenge := range gs.Challenges {
+		if _, ok := challenges[challenge.WorkId]; ok {
+			fail("duplicate challenge on work %s", challenge.WorkId)
+		}
+		challenges[challenge.WorkId] = challenge
+		if _, err := sdk.AccAddressFromBech32(challenge.Challenger); err != nil {
+			fail("challenge on work %s: invalid challenger address %q: %w", challenge.WorkId, challenge.Challenger, err)
+		}
+		if _, err := SDKCoin(challenge.Bond); err != nil {
+			fail("challenge on work %s: invalid bond: %w", challenge.WorkId, err)
+		}
+		if challenge.OriginalStatus != WorkStatusValidated && challenge.OriginalStatus != WorkStatusRejected {
+			fail("challenge on work %s: original status %q is not a finalized status", challenge.WorkId, challenge.OriginalStatus)
+		}
+	}
+
+	seenWork := make(map[string]bool)
+	var validated, rejected uint64
+	for i, work := range gs.Work {
+		if work.Id == "" {
+			fail("work unit %d has an empty id", i)
+			continue
+		}
+		if seenWork[work.Id] {
+			fail("duplicate work unit %s", work.Id)
+			continue
+		}
+		seenWork[work.Id] = true
+
+		if err := ValidateKeyComponent(work.Id, MaxWorkIDLength); err != nil {
+			fail("work unit %s: id %w", work.Id, err)
+		}
+		if err := ValidateKeyComponent(work.Type, MaxIndexedFieldLength); err != nil {
+			fail("work unit %s: type %w", work.Id, err)
+		}
+		if err := ValidateKeyComponent(work.Submitter, MaxIndexedFieldLength); err != nil {
+			fail("work unit %s: submitter %w", work.Id, err)
+		}
+		if !workStatuses[work.Status] {
+			fail("work unit %s: unknown status %q", work.Id, work.Status)
+		}
+		if work.ValidatedAt != 0 && work.ValidatedAt < work.SubmittedAt {
+			fail("work unit %s: validated at height %d before it was submitted at height %d", work.Id, work.ValidatedAt, work.SubmittedAt)
+		}
+		if work.Confidence > 100 {
+			fail("work unit %s: confidence %d exceeds 100", work.Id, work.Confidence)
+		}
+
+		status := work.Status
+		if challenge, ok := challenges[work.Id]; ok && !challenge.Resolved {
+			status = challenge.OriginalStatus
+		} else if work.Status == WorkStatusChallenged {
+			fail("work unit %s: challenged without an unresolved challenge", work.Id)
+		}
+		switch status {
+		case WorkStatusValidated:
+			validated++
+		case WorkStatusRejected:
+			rejected++
+		}
+	}
+
+	for _, challenge := range gs.Challenges {
+		if !seenWork[challenge.WorkId] {
+			fail("challenge on unknown work %s", challenge.WorkId)
+		}
+	}
+
+	for _, votes := range []struct {
+		kind  string
+		votes []*WorkVote
+	}{{"vote", gs.Votes}, {"challenge vote", gs.ChallengeVotes}} {
+		seenVotes := make(map[string]bool)
+		for _, vote := range votes.votes {
+			if !seenWork[vote.WorkId] {
+				fail("%s of %s on unknown work %s", votes.kind, vote.Validator, vote.WorkId)
+			}
+			if _, err := sdk.AccAddressFromBech32(vote.Validator); err != nil {
+				fail("%s on work %s: invalid validator address %q: %w", votes.kind, vote.WorkId, vote.Validator, err)
+			}
+			if vote.Confidence > 100 {
+				fail("%s of %s on work %s: confidence %d exceeds 100", votes.kind, vote.Validator, vote.WorkId, vote.Confidence)
+			}
+			key := vote.WorkId + "/" + vote.Validator
+			if seenVotes[key] {
+				fail("duplicate %s of %s on work %s", votes.kind, vote.Validator, vote.WorkId)
+			}
+			seenVotes[key] = true
+		}
+	}
+
+	if gs.WorkQueue != nil {
+		if total := uint64(len(gs.Work)); gs.WorkQueue.TotalSubmitted != total {
+			fail("total submitted is %d but genesis holds %d work units", gs.WorkQueue.TotalSubmitted, total)
+		}
+		if gs.WorkQueue.TotalValidated != validated {
+			fail("total validated is %d but genesis holds %d validated work units", gs.WorkQueue.TotalValidated, validated)
+		}
+		if gs.WorkQueue.TotalRejected != rejected {
+			fail("total rejected is %d but genesis holds %d rejected work units", gs.WorkQueue.TotalRejected, rejected)
+		}
+	}
+
+	seenCommits := make(map[string]bool)
+	for _, commit := range gs.Commits {
+		if commit.WorkId == "" {
+			fail("validation commitment with empty work id")
+		} else if !seenWork[commit.WorkId] {
+			fail("commitment of %s on unknown work %s", commit.Validator, commit.WorkId)
+		}
+		if _, err := sdk.AccAddressFromBech32(commit.Validator); err != nil {
+			fail("invalid commitment validator address %q: %w", commit.Validator, err)
+		}
+		if len(commit.Commitment) != sha256.Size {
+			fail("commitment of %s on %s must be %d bytes", commit.Validator, commit.WorkId, sha256.Size)
+		}
+		key := commit.WorkId + "/" + commit.Validator
+		if seenCommits[key] {
+			fail("duplicate commitment of %s on work %s", commit.Validator, commit.WorkId)
+		}
+		seenCommits[key] = true
+	}
+
+	seenBounties := make(map[string]bool)
+	for _, bounty := range gs.Bounties {
+		if _, err := SDKCoin(bounty.Amount); err != nil {
+			fail("invalid bounty for work %s: %w", bounty.WorkId, err)
+		}
+		if seenBounties[bounty.WorkId] {
+			fail("duplicate bounty for work %s", bounty.WorkId)
+		}
+		if bounty.SettlesAt < 0 {
+			fail("bounty for work %s: settlement height %d is negative", bounty.WorkId, bounty.SettlesAt)
+		}
+		seenBounties[bounty.WorkId] = true
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestGenesisValidateReportsEveryProblem(t *testing.T) {
+	if err := types.DefaultGenesis().Validate(); err != nil {
+		t.Fatalf("default genesis is invalid: %v", err)
+	}
+
+	validator := sdk.AccAddress("validator___________").String()
+	genState := types.DefaultGenesis()
+	genState.Work = []*types.WorkUnit{
+		{Id: "a", Type: types.WorkTypeCrypto, Status: types.WorkStatusValidated, SubmittedAt: 5},
+		{Id: "a", Type: types.WorkTypeCrypto, Status: types.WorkStatusPending},
+		{Id: "b", Type: types.WorkTypeCrypto, Status: "lost", SubmittedAt: 5, ValidatedAt: 4},
+		{Id: "c", Type: types.WorkTypeCrypto, Status: types.WorkStatusChallenged},
+	}
+	genState.Votes = []*types.WorkVote{
+		{WorkId: "a", Validator: validator, Confidence: 90},
+		{WorkId: "a", Validator: validator, Confidence: 101},
+		{WorkId: "missing", Validator: "validator"},
+	}
+	genState.WorkQueue = &types.WorkQueue{TotalSubmitted: 4, TotalValidated: 2}
+
+	err := genState.Validate()
+	if err == nil {
+		t.Fatal("invalid genesis passed validation")
+	}
+	for _, problem := range []string{
+		"duplicate work unit a",
+		`work unit b: unknown status "lost"`,
+		"work unit b: validated at height 4 before it was submitted at height 5",
+		"work unit c: challenged without an unresolved challenge",
+		"vote of " + validator + " on work a: confidence 101 exceeds 100",
+		"duplicate vote of " + validator + " on work a",
+		"vote of validator on unknown work missing",
+		`vote on work missing: invalid validator address "validator"`,
+		"total validated is 2 but genesis holds 1 validated work units",
+	} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Fatalf("validation error does not report %q:\n%v", problem, err)
+		}
+	}
+	if strings.Contains(err.Error(), "total submitted") {
+		t.Fatalf("validation error reports a matching total submitted:\n%v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"context"
+)
+
+// WorkqueueHooks is implemented by modules that react to work units entering
+// and leaving the queue. A hook returning an error aborts the state change
+// that triggered it.
+type WorkqueueHooks interface {
+	// AfterWorkSubmitted is called once a work unit has been queued
+	AfterWorkSubmitted(ctx context.Context, work *WorkUnit) error
+
+	// AfterWorkValidated is called once a work unit is finalized as validated
+	AfterWorkValidated(ctx context.Context, work *WorkUnit) error
+
+	// AfterWorkRejected is called once a work unit is finalized as rejected
+	AfterWorkRejected(ctx context.Context, work *WorkUnit) error
+
+	// AfterWorkExpired is called once pending work expires unfinalized
+	AfterWorkExpired(ctx context.Context, work *WorkUnit) error
+
+	// AfterWorkOverturned is called once a challenge reverses the finalized
+	// status of a work unit. The work carries its new status and
+	// originalStatus is the status reversed, so hooks can undo what they did
+	// on the first finalization.
+	AfterWorkOverturned(ctx context.Context, work *WorkUnit, originalStatus string) error
+}
+
+var _ WorkqueueHooks = MultiWorkqueueHooks{}
+
+// MultiWorkqueueHooks combines multiple workqueue hooks, calling them in order
+// and stopping at the first error
+type MultiWorkqueueHooks []WorkqueueHooks
+
+// NewMultiWorkqueueHooks returns hooks that call each of the given hooks
+func NewMultiWorkqueueHooks(hooks ...WorkqueueHooks) MultiWorkqueueHooks {
+	return hooks
+}
+
+// AfterWorkSubmitted calls AfterWorkSubmitted on each hook
+func (h MultiWorkqueueHooks) AfterWorkSubmitted(ctx context.Context, work *WorkUnit) error {
+	for i := range h {
+		if err := h[i].AfterWorkSubmitted(ctx, work); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterWorkValidated calls AfterWorkValidated on each hook
+func (h MultiWorkqueueHooks) AfterWorkValidated(ctx context.Context, work *WorkUnit) error {
+	for i := range h {
+		if err := h[i].AfterWorkValidated(ctx, work); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterWorkRejected calls AfterWorkRejected on each hook
+func (h MultiWorkqueueHooks) AfterWorkRejected(ctx context.Context, work *WorkUnit) error {
+	for i := range h {
+		if err := h[i].AfterWorkRejected(ctx, work); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterWorkExpired calls AfterWorkExpired on each hook
+func (h MultiWorkqueueHooks) AfterWorkExpired(ctx context.Context, work *WorkUnit) error {
+	for i := range h {
+		if err := h[i].AfterWorkExpired(ctx, work); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterWorkOverturned calls AfterWorkOverturned on each hook
+func (h MultiWorkqueueHooks) AfterWorkOverturned(ctx context.Context, work *WorkUnit, originalStatus string) error {
+	for i := range h {
+		if err := h[i].AfterWorkOverturned(ctx, work, originalStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
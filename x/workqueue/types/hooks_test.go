@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// recordingHooks records each call in a log shared between hooks, and fails
+// with err when it is set
+type recordingHooks struct {
+	name string
+	log  *[]string
+	err  error
+}
+
+func (h recordingHooks) record(callback string) error {
+	*h.log = append(*h.log, h.name+"."+callback)
+	return h.err
+}
+
+func (h recordingHooks) AfterWorkSubmitted(context.Context, *types.WorkUnit) error {
+	return h.record("submitted")
+}
+
+func (h recordingHooks) AfterWorkValidated(context.Context, *types.WorkUnit) error {
+	return h.record("validated")
+}
+
+func (h recordingHooks) AfterWorkRejected(context.Context, *types.WorkUnit) error {
+	return h.record("rejected")
+}
+
+func (h recordingHooks) AfterWorkExpired(context.Context, *types.WorkUnit) error {
+	return h.record("expired")
+}
+
+func (h recordingHooks) AfterWorkOverturned(context.Context, *types.WorkUnit, string) error {
+	return h.record("overturned")
+}
+
+func TestMultiWorkqueueHooks(t *testing.T) {
+	callbacks := map[string]func(hooks types.WorkqueueHooks) error{
+		"submitted": func(hooks types.WorkqueueHooks) error {
+			return hooks.AfterWorkSubmitted(context.Background(), &types.WorkUnit{})
+		},
+		"validated": func(hooks types.WorkqueueHooks) error {
+			return hooks.AfterWorkValidated(context.Background(), &types.WorkUnit{})
+		},
+		"rejected": func(hooks types.WorkqueueHooks) error {
+			return hooks.AfterWorkRejected(context.Background(), &types.WorkUnit{})
+		},
+		"expired": func(hooks types.WorkqueueHooks) error {
+			return hooks.AfterWorkExpired(context.Background(), &types.WorkUnit{})
+		},
+		"overturned": func(hooks types.WorkqueueHooks) error {
+			return hooks.AfterWorkOverturned(context.Background(), &types.WorkUnit{}, types.WorkStatusValidated)
+		},
+	}
+
+	for callback, call := range callbacks {
+		t.Run(callback, func(t *testing.T) {
+			// Hooks are called in the order they were combined
+			var log []string
+			hooks := types.NewMultiWorkqueueHooks(
+				recordingHooks{name: "first", log: &log},
+				recordingHooks{name: "second", log: &log},
+				recordingHooks{name: "third", log: &log},
+			)
+			if err := call(hooks); err != nil {
+				t.Fatalf("hooks failed: %v", err)
+			}
+			if want := []string{"first." + callback, "second." + callback, "third." + callback}; !reflect.DeepEqual(log, want) {
+				t.Fatalf("hooks were called as %v, want %v", log, want)
+			}
+
+			// The first error is returned and stops the later hooks
+			log = nil
+			failure := errors.New("failure")
+			hooks = types.NewMultiWorkqueueHooks(
+				recordingHooks{name: "first", log: &log},
+				recordingHooks{name: "second", log: &log, err: failure},
+				recordingHooks{name: "third", log: &log, err: errors.New("later failure")},
+			)
+			if err := call(hooks); !errors.Is(err, failure) {
+				t.Fatalf("hooks returned %v, want %v", err, failure)
+			}
+			if want := []string{"first." + callback, "second." + callback}; !reflect.DeepEqual(log, want) {
+				t.Fatalf("hooks were called as %v, want %v", log, want)
+			}
+		})
+	}
+
+	// No hooks is a no-op
+	if err := types.NewMultiWorkqueueHooks().AfterWorkValidated(context.Background(), &types.WorkUnit{}); err != nil {
+		t.Fatalf("empty hooks failed: %v", err)
+	}
+}
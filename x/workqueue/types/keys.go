@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "workqueue"
@@ -15,22 +22,116 @@ const (
 )
 
 var (
-	// KeyPrefixWorkUnit is the prefix for work units
-	KeyPrefixWorkUnit = []byte{0x01}
+	// KeyPrefixWorkUnit is the prefix for work units, keyed by ID
+	KeyPrefixWorkUnit = collections.NewPrefix(0x01)
+
+	// KeyPrefixValidatorStats is the prefix for validator statistics, keyed by
+	// address
+	KeyPrefixValidatorStats = collections.NewPrefix(0x02)
+
+	// KeyPrefixWorkByStatus is the prefix for the status -> work ID index
+	KeyPrefixWorkByStatus = collections.NewPrefix(0x04)
+
+	// KeyPrefixWorkByType is the prefix for the work type -> work ID index
+	KeyPrefixWorkByType = collections.NewPrefix(0x05)
+
+	// KeyPrefixWorkBySubmittedAt is the prefix for the submitted height -> work ID index
+	KeyPrefixWorkBySubmittedAt = collections.NewPrefix(0x06)
+
+	// KeyPrefixLeaseExpiry is the prefix for the lease expiry height -> work ID index
+	KeyPrefixLeaseExpiry = collections.NewPrefix(0x07)
+
+	// KeyPrefixWorkBySubmitter is the prefix for the submitter -> work ID index
+	KeyPrefixWorkBySubmitter = collections.NewPrefix(0x08)
+
+	// KeyPrefixQuorumRule is the prefix for per work type quorum rules
+	KeyPrefixQuorumRule = collections.NewPrefix(0x09)
+
+	// KeyPrefixWorkVote is the prefix for validator votes on work units, keyed
+	// by work ID then validator
+	KeyPrefixWorkVote = collections.NewPrefix(0x0A)
+
+	// KeyPrefixChallenge is the prefix for challenges raised against work units
+	KeyPrefixChallenge = collections.NewPrefix(0x0B)
+
+	// KeyPrefixChallengeVote is the prefix for re-validation votes on
+	// challenged work units, keyed by work ID then validator
+	KeyPrefixChallengeVote = collections.NewPrefix(0x0C)
+
+	// KeyPrefixValidatorBond is the prefix for validator bonds
+	KeyPrefixValidatorBond = collections.NewPrefix(0x0D)
+
+	// KeyPrefixUnbonding is the prefix for the unbonding queue, keyed by
+	// completion height then validator
+	KeyPrefixUnbonding = collections.NewPrefix(0x0E)
 
-	// KeyPrefixValidatorStats is the prefix for validator statistics
-	KeyPrefixValidatorStats = []byte{0x02}
+	// KeyPrefixBounty is the prefix for bounties attached to work units
+	KeyPrefixBounty = collections.NewPrefix(0x0F)
 
-	// KeyWorkQueue stores the work queue state
-	KeyWorkQueue = []byte{0x03}
+	// KeyPrefixWorkExpiry is the prefix for the expiry height -> pending work
+	// ID index
+	KeyPrefixWorkExpiry = collections.NewPrefix(0x10)
+
+	// KeyParams stores the module parameters
+	KeyParams = collections.NewPrefix(0x11)
+
+	// KeyPrefixWorkType is the prefix for registered work type definitions
+	KeyPrefixWorkType = collections.NewPrefix(0x12)
+
+	// KeyPrefixPendingByPriority is the prefix for the scheduled height ->
+	// pending work ID index, served in ascending order
+	KeyPrefixPendingByPriority = collections.NewPrefix(0x13)
+
+	// KeyPrefixValidationCommit is the prefix for unrevealed validation
+	// commitments, keyed by work ID then validator
+	KeyPrefixValidationCommit = collections.NewPrefix(0x14)
+
+	// KeyPrefixRevealExpiry is the prefix for the reveal deadline -> revealing
+	// work ID index
+	KeyPrefixRevealExpiry = collections.NewPrefix(0x15)
+
+	// KeyTotalSubmitted stores the number of submitted work units
+	KeyTotalSubmitted = collections.NewPrefix(0x16)
+
+	// KeyTotalValidated stores the number of validated work units
+	KeyTotalValidated = collections.NewPrefix(0x17)
+
+	// KeyTotalRejected stores the number of rejected work units
+	KeyTotalRejected = collections.NewPrefix(0x18)
+
+	// KeyPrefixChallengeByCreatedAt is the prefix for the creation height ->
+	// work ID index of unresolved challenges
+	KeyPrefixChallengeByCreatedAt = collections.NewPrefix(0x19)
+
+	// KeyPrefixBountySettlement is the prefix for the settlement height ->
+	// work ID index of escrowed bounties on finalized work
+	KeyPrefixBountySettlement = collections.NewPrefix(0x1A)
+
+	// KeyPrefixUnbondingByValidator is the prefix for the validator ->
+	// completion height index of the unbonding queue
+	KeyPrefixUnbondingByValidator = collections.NewPrefix(0x1B)
+
+	// KeyPrefixCommitExpiry is the prefix for the commit deadline -> work ID
+	// index of rounds collecting commitments
+	KeyPrefixCommitExpiry = collections.NewPrefix(0x1C)
 )
 
-// WorkUnitKey returns the key for a work unit
-func WorkUnitKey(workID string) []byte {
-	return append(KeyPrefixWorkUnit, []byte(workID)...)
-}
+// MaxIndexedFieldLength is the maximum length of a string field used as an
+// index key component
+const MaxIndexedFieldLength = 255
+
+// MaxWorkIDLength is the maximum length of a client-supplied work ID
+const MaxWorkIDLength = 128
 
-// ValidatorStatsKey returns the key for validator statistics
-func ValidatorStatsKey(validatorAddr string) []byte {
-	return append(KeyPrefixValidatorStats, []byte(validatorAddr)...)
+// ValidateKeyComponent checks that a string can lead a composite key: it must
+// fit the given length and cannot contain the NUL byte that terminates the
+// string within the key
+func ValidateKeyComponent(s string, maxLength int) error {
+	if len(s) > maxLength {
+		return fmt.Errorf("cannot exceed %d bytes", maxLength)
+	}
+	if strings.IndexByte(s, 0) >= 0 {
+		return fmt.Errorf("cannot contain a NUL byte")
+	}
+	return nil
 }
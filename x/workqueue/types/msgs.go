@@ -0,0 +1,172 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.HasValidateBasic = &MsgSubmitWork{}
+	_ sdk.HasValidateBasic = &MsgClaimWork{}
+	_ sdk.HasValidateBasic = &MsgValidateWork{}
+	_ sdk.HasValidateBasic = &MsgRejectWork{}
+	_ sdk.HasValidateBasic = &MsgChallengeWork{}
+	_ sdk.HasValidateBasic = &MsgBond{}
+	_ sdk.HasValidateBasic = &MsgUnbond{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgSetWorkTypeDefinition{}
+	_ sdk.HasValidateBasic = &MsgCommitValidation{}
+	_ sdk.HasValidateBasic = &MsgRevealValidation{}
+)
+
+// ValidateBasic performs stateless validation of MsgSubmitWork
+func (msg *MsgSubmitWork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address: %s", err)
+	}
+	if msg.WorkType == "" {
+		return errorsmod.Wrap(ErrInvalidWorkType, "work type cannot be empty")
+	}
+	if msg.WorkId != "" {
+		if err := ValidateClientWorkID(msg.WorkId, msg.WorkType, msg.WorkData); err != nil {
+			return errorsmod.Wrap(ErrInvalidWorkID, err.Error())
+		}
+	}
+	if msg.Bounty != nil {
+		bounty, err := SDKCoin(msg.Bounty)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidBounty, err.Error())
+		}
+		if !bounty.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidBounty, "bounty must be positive")
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgClaimWork
+func (msg *MsgClaimWork) ValidateBasic() error {
+	return validateWorkResult(msg.Validator, msg.WorkId)
+}
+
+// ValidateBasic performs stateless validation of MsgValidateWork
+func (msg *MsgValidateWork) ValidateBasic() error {
+	if err := validateWorkResult(msg.Validator, msg.WorkId); err != nil {
+		return err
+	}
+	if msg.Confidence > 100 {
+		return errorsmod.Wrap(ErrInvalidConfidence, "confidence cannot exceed 100")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgCommitValidation
+func (msg *MsgCommitValidation) ValidateBasic() error {
+	if err := validateWorkResult(msg.Validator, msg.WorkId); err != nil {
+		return err
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidCommitment, "commitment must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgRevealValidation
+func (msg *MsgRevealValidation) ValidateBasic() error {
+	if err := validateWorkResult(msg.Validator, msg.WorkId); err != nil {
+		return err
+	}
+	if msg.Confidence > 100 {
+		return errorsmod.Wrap(ErrInvalidConfidence, "confidence cannot exceed 100")
+	}
+	if len(msg.Salt) < MinCommitSaltLength {
+		return errorsmod.Wrapf(ErrInvalidCommitment, "salt must be at least %d bytes", MinCommitSaltLength)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgRejectWork
+func (msg *MsgRejectWork) ValidateBasic() error {
+	return validateWorkResult(msg.Validator, msg.WorkId)
+}
+
+// ValidateBasic performs stateless validation of MsgChallengeWork
+func (msg *MsgChallengeWork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid challenger address: %s", err)
+	}
+	if msg.WorkId == "" {
+		return errorsmod.Wrap(ErrInvalidWorkID, "work id cannot be empty")
+	}
+	bond, err := SDKCoin(msg.Bond)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidBond, err.Error())
+	}
+	if !bond.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBond, "bond must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgBond
+func (msg *MsgBond) ValidateBasic() error {
+	return validateStake(msg.Validator, msg.Amount)
+}
+
+// ValidateBasic performs stateless validation of MsgUnbond
+func (msg *MsgUnbond) ValidateBasic() error {
+	return validateStake(msg.Validator, msg.Amount)
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateParams
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgSetWorkTypeDefinition
+func (msg *MsgSetWorkTypeDefinition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Definition.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidWorkTypeDefinition, err.Error())
+	}
+	return nil
+}
+
+// validateStake checks the fields shared by messages moving validator stake
+func validateStake(validator string, amount *basev1beta1.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	coin, err := SDKCoin(amount)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidBond, err.Error())
+	}
+	if !coin.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBond, "amount must be positive")
+	}
+	return nil
+}
+
+// validateWorkResult checks the fields shared by messages a validator sends
+// about a work unit
+func validateWorkResult(validator, workID string) error {
+	if _, err := sdk.AccAddressFromBech32(validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	if workID == "" {
+		return errorsmod.Wrap(ErrInvalidWorkID, "work id cannot be empty")
+	}
+	return nil
+}
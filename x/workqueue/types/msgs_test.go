@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestMsgValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		wantErr error
+	}{
+		{"submit work", &types.MsgSubmitWork{Submitter: addr, WorkType: "crypto"}, nil},
+		{"submit without submitter", &types.MsgSubmitWork{WorkType: "crypto"}, sdkerrors.ErrInvalidAddress},
+		{"submit from malformed submitter", &types.MsgSubmitWork{Submitter: "submitter", WorkType: "crypto"}, sdkerrors.ErrInvalidAddress},
+		{"submit without work type", &types.MsgSubmitWork{Submitter: addr}, types.ErrInvalidWorkType},
+		{"submit under own content id", &types.MsgSubmitWork{Submitter: addr, WorkType: "crypto", WorkId: types.WorkID("crypto", []byte("data")), WorkData: []byte("data")}, nil},
+		{"submit under other content id", &types.MsgSubmitWork{Submitter: addr, WorkType: "crypto", WorkId: types.WorkID("crypto", []byte("other")), WorkData: []byte("data")}, types.ErrInvalidWorkID},
+		{"claim work", &types.MsgClaimWork{Validator: addr, WorkId: "work"}, nil},
+		{"claim from malformed validator", &types.MsgClaimWork{Validator: "validator", WorkId: "work"}, sdkerrors.ErrInvalidAddress},
+		{"claim without work id", &types.MsgClaimWork{Validator: addr}, types.ErrInvalidWorkID},
+		{"validate work", &types.MsgValidateWork{Validator: addr, WorkId: "work", Confidence: 100}, nil},
+		{"validate without validator", &types.MsgValidateWork{WorkId: "work"}, sdkerrors.ErrInvalidAddress},
+		{"validate without work id", &types.MsgValidateWork{Validator: addr}, types.ErrInvalidWorkID},
+		{"validate above full confidence", &types.MsgValidateWork{Validator: addr, WorkId: "work", Confidence: 101}, types.ErrInvalidConfidence},
+		{"reject work", &types.MsgRejectWork{Validator: addr, WorkId: "work"}, nil},
+		{"reject from malformed validator", &types.MsgRejectWork{Validator: addr + "x", WorkId: "work"}, sdkerrors.ErrInvalidAddress},
+		{"reject without work id", &types.MsgRejectWork{Validator: addr}, types.ErrInvalidWorkID},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Fatalf("failed to validate: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("validation returned %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
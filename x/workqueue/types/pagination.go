@@ -0,0 +1,48 @@
+package types
+
+import (
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// NewPageRequest converts an SDK page request, such as one read from CLI
+// flags, into the page request carried by workqueue queries.
+func NewPageRequest(req *query.PageRequest) *queryv1beta1.PageRequest {
+	if req == nil {
+		return nil
+	}
+	return &queryv1beta1.PageRequest{
+		Key:        req.Key,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		CountTotal: req.CountTotal,
+		Reverse:    req.Reverse,
+	}
+}
+
+// SDKPageRequest converts a workqueue query page request into the SDK page
+// request understood by the query pagination helpers.
+func SDKPageRequest(req *queryv1beta1.PageRequest) *query.PageRequest {
+	if req == nil {
+		return nil
+	}
+	return &query.PageRequest{
+		Key:        req.Key,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		CountTotal: req.CountTotal,
+		Reverse:    req.Reverse,
+	}
+}
+
+// NewPageResponse converts an SDK page response into the page response
+// carried by workqueue queries.
+func NewPageResponse(res *query.PageResponse) *queryv1beta1.PageResponse {
+	if res == nil {
+		return nil
+	}
+	return &queryv1beta1.PageResponse{
+		NextKey: res.NextKey,
+		Total:   res.Total,
+	}
+}
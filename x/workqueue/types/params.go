@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	stdmath "math"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default module parameters
+func DefaultParams() *Params {
+	defaultQuorum := DefaultQuorumRule("")
+	return &Params{
+		MaxDataSize:                 DefaultMaxDataSize,
+		DefaultRequiredVotes:        defaultQuorum.RequiredVotes,
+		DefaultRequiredAgreement:    defaultQuorum.RequiredAgreement,
+		DefaultMinAverageConfidence: defaultQuorum.MinAverageConfidence,
+		LeaseBlocks:                 DefaultLeaseBlocks,
+		WorkExpiryBlocks:            DefaultWorkExpiryBlocks,
+		ChallengeWindow:             DefaultChallengeWindow,
+		BondDenom:                   DefaultBondDenom,
+		MinChallengeBond:            math.NewInt(DefaultMinChallengeBond).String(),
+		MinValidatorBond:            math.NewInt(DefaultMinValidatorBond).String(),
+		UnbondingBlocks:             DefaultUnbondingBlocks,
+		SlashFraction:               DefaultSlashFraction,
+		JailThreshold:               DefaultJailThreshold,
+		JailBlocks:                  DefaultJailBlocks,
+		BountyBurnFraction:          DefaultBountyBurnFraction,
+		MaxPriority:                 DefaultMaxPriority,
+		PriorityAgingBlocks:         DefaultPriorityAgingBlocks,
+		MaxAssignmentsPerBlock:      DefaultMaxAssignmentsPerBlock,
+		MaxValidatorLoad:            DefaultMaxValidatorLoad,
+		CommitRevealEnabled:         DefaultCommitRevealEnabled,
+		RevealBlocks:                DefaultRevealBlocks,
+		CommitBlocks:                DefaultCommitBlocks,
+	}
+}
+
+// Validate checks that the parameters are well formed
+func (p *Params) Validate() error {
+	if p == nil {
+		return fmt.Errorf("params cannot be empty")
+	}
+
+	if err := p.QuorumRuleFor("default").Validate(); err != nil {
+		return err
+	}
+
+	for name, blocks := range map[string]int64{
+		"lease blocks":       p.LeaseBlocks,
+		"work expiry blocks": p.WorkExpiryBlocks,
+		"challenge window":   p.ChallengeWindow,
+		"unbonding blocks":   p.UnbondingBlocks,
+		"jail blocks":        p.JailBlocks,
+		"priority aging":     p.PriorityAgingBlocks,
+		"reveal blocks":      p.RevealBlocks,
+		"commit blocks":      p.CommitBlocks,
+	} {
+		if blocks <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
+
+	// Unbonding stake must stay slashable for as long as work it voted on can
+	// still be overturned: a challenge raised at the end of the window has
+	// another window to resolve
+	if p.UnbondingBlocks <= 2*p.ChallengeWindow {
+		return fmt.Errorf("unbonding blocks (%d) must exceed twice the challenge window (%d)", p.UnbondingBlocks, p.ChallengeWindow)
+	}
+
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return fmt.Errorf("invalid bond denom: %w", err)
+	}
+
+	for name, amount := range map[string]string{
+		"min challenge bond": p.MinChallengeBond,
+		"min validator bond": p.MinValidatorBond,
+	} {
+		value, ok := math.NewIntFromString(amount)
+		if !ok || value.IsNegative() {
+			return fmt.Errorf("%s must be a non-negative integer, got %q", name, amount)
+		}
+	}
+
+	for name, fraction := range map[string]string{
+		"slash fraction":       p.SlashFraction,
+		"bounty burn fraction": p.BountyBurnFraction,
+	} {
+		value, err := math.LegacyNewDecFromStr(fraction)
+		if err != nil || value.IsNegative() || value.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s must be between 0 and 1, got %q", name, fraction)
+		}
+	}
+
+	if p.JailThreshold == 0 {
+		return fmt.Errorf("jail threshold must be positive")
+	}
+
+	if p.AssignmentEnabled && (p.MaxAssignmentsPerBlock == 0 || p.MaxValidatorLoad == 0) {
+		return fmt.Errorf("assignment requires positive max assignments per block and max validator load")
+	}
+
+	// The head start of the highest priority must fit in a block height
+	if p.MaxPriority > 0 && p.PriorityAgingBlocks > stdmath.MaxInt64/2/int64(p.MaxPriority) {
+		return fmt.Errorf("priority aging blocks (%d) too large for max priority %d", p.PriorityAgingBlocks, p.MaxPriority)
+	}
+
+	return nil
+}
+
+// QuorumRuleFor returns the default quorum rule applied to a work type
+func (p *Params) QuorumRuleFor(workType string) *QuorumRule {
+	return &QuorumRule{
+		WorkType:             workType,
+		RequiredVotes:        p.DefaultRequiredVotes,
+		RequiredAgreement:    p.DefaultRequiredAgreement,
+		MinAverageConfidence: p.DefaultMinAverageConfidence,
+	}
+}
+
+// ScheduledAt returns the virtual submission height of work submitted at the
+// given height with the given priority. Each priority level moves the work
+// ahead of PriorityAgingBlocks worth of later submissions, which is also how
+// long lower priority work waits to catch up with it.
+func (p *Params) ScheduledAt(submittedAt int64, priority uint32) int64 {
+	return submittedAt - int64(priority)*p.PriorityAgingBlocks
+}
+
+// MinChallengeBondCoin returns the minimum challenge bond
+func (p *Params) MinChallengeBondCoin() sdk.Coin {
+	amount, _ := math.NewIntFromString(p.MinChallengeBond)
+	return sdk.NewCoin(p.BondDenom, amount)
+}
+
+// MinValidatorBondCoin returns the minimum validator bond
+func (p *Params) MinValidatorBondCoin() sdk.Coin {
+	amount, _ := math.NewIntFromString(p.MinValidatorBond)
+	return sdk.NewCoin(p.BondDenom, amount)
+}
+
+// SlashFractionDec returns the slash fraction
+func (p *Params) SlashFractionDec() math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(p.SlashFraction)
+}
+
+// BountyBurnFractionDec returns the bounty burn fraction
+func (p *Params) BountyBurnFractionDec() math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(p.BountyBurnFraction)
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestScheduledAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		submittedAt int64
+		priority    uint32
+		aging       int64
+		want        int64
+	}{
+		{"no priority", 500, 0, 100, 500},
+		{"one level", 500, 1, 100, 400},
+		{"several levels", 500, 3, 100, 200},
+		{"ahead of genesis", 10, 10, 100, -990},
+		{"no aging", 500, 10, 0, 500},
+		{"largest head start", stdmath.MaxInt64, 10, stdmath.MaxInt64 / 2 / 10, stdmath.MaxInt64 - 10*(stdmath.MaxInt64/2/10)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.PriorityAgingBlocks = tc.aging
+			if got := params.ScheduledAt(tc.submittedAt, tc.priority); got != tc.want {
+				t.Fatalf("scheduled at %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParamsBoundPriorityAging(t *testing.T) {
+	params := types.DefaultParams()
+	params.PriorityAgingBlocks = stdmath.MaxInt64 / 2 / int64(params.MaxPriority)
+	if err := params.Validate(); err != nil {
+		t.Fatalf("failed to validate the largest aging: %v", err)
+	}
+
+	// A larger head start could overflow the scheduled height
+	params.PriorityAgingBlocks++
+	if err := params.Validate(); err == nil {
+		t.Fatal("aging past the largest head start validated")
+	}
+}
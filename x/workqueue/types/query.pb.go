@@ -7,6 +7,8 @@
 package types
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -112,28 +114,1017 @@ func (x *QueryWorkResponse) GetWork() *WorkUnit {
 	return nil
 }
 
+// WorkFilter restricts the work units returned by a query. Empty fields match
+// everything.
+type WorkFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status only matches work in this status
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// WorkType only matches work of this type
+	WorkType string `protobuf:"bytes,2,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	// Submitter only matches work submitted by this address
+	Submitter string `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// MinSubmittedAt only matches work submitted at or after this block height
+	MinSubmittedAt int64 `protobuf:"varint,4,opt,name=min_submitted_at,json=minSubmittedAt,proto3" json:"min_submitted_at,omitempty"`
+	// MaxSubmittedAt only matches work submitted at or before this block height
+	MaxSubmittedAt int64 `protobuf:"varint,5,opt,name=max_submitted_at,json=maxSubmittedAt,proto3" json:"max_submitted_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkFilter) Reset() {
+	*x = WorkFilter{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkFilter) ProtoMessage() {}
+
+func (x *WorkFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkFilter.ProtoReflect.Descriptor instead.
+func (*WorkFilter) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkFilter) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+func (x *WorkFilter) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *WorkFilter) GetMinSubmittedAt() int64 {
+	if x != nil {
+		return x.MinSubmittedAt
+	}
+	return 0
+}
+
+func (x *WorkFilter) GetMaxSubmittedAt() int64 {
+	if x != nil {
+		return x.MaxSubmittedAt
+	}
+	return 0
+}
+
 // QueryPendingWorkRequest is the request for querying pending work
 type QueryPendingWorkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter narrows the pending work returned; its status must be empty or pending
+	Filter *WorkFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPendingWorkRequest) Reset() {
+	*x = QueryPendingWorkRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPendingWorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingWorkRequest) ProtoMessage() {}
+
+func (x *QueryPendingWorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPendingWorkRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingWorkRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryPendingWorkRequest) GetFilter() *WorkFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryPendingWorkRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPendingWorkResponse is the response for querying pending work
+type QueryPendingWorkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PendingWork is the list of pending work units
+	PendingWork []*WorkUnit `protobuf:"bytes,1,rep,name=pending_work,json=pendingWork,proto3" json:"pending_work,omitempty"`
+	// Pagination defines the pagination in the response
+	Pagination    *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPendingWorkResponse) Reset() {
+	*x = QueryPendingWorkResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPendingWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingWorkResponse) ProtoMessage() {}
+
+func (x *QueryPendingWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPendingWorkResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingWorkResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryPendingWorkResponse) GetPendingWork() []*WorkUnit {
+	if x != nil {
+		return x.PendingWork
+	}
+	return nil
+}
+
+func (x *QueryPendingWorkResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryListWorkRequest is the request for listing work units
+type QueryListWorkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter narrows the work returned
+	Filter *WorkFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryListWorkRequest) Reset() {
+	*x = QueryListWorkRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryListWorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListWorkRequest) ProtoMessage() {}
+
+func (x *QueryListWorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryListWorkRequest.ProtoReflect.Descriptor instead.
+func (*QueryListWorkRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryListWorkRequest) GetFilter() *WorkFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryListWorkRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryListWorkResponse is the response for listing work units
+type QueryListWorkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Work is the list of matching work units
+	Work []*WorkUnit `protobuf:"bytes,1,rep,name=work,proto3" json:"work,omitempty"`
+	// Pagination defines the pagination in the response
+	Pagination    *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryListWorkResponse) Reset() {
+	*x = QueryListWorkResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryListWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListWorkResponse) ProtoMessage() {}
+
+func (x *QueryListWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryListWorkResponse.ProtoReflect.Descriptor instead.
+func (*QueryListWorkResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryListWorkResponse) GetWork() []*WorkUnit {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *QueryListWorkResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryWorkBySubmitterRequest is the request for querying work by submitter
+type QueryWorkBySubmitterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Submitter is the address that submitted the work
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkBySubmitterRequest) Reset() {
+	*x = QueryWorkBySubmitterRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkBySubmitterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkBySubmitterRequest) ProtoMessage() {}
+
+func (x *QueryWorkBySubmitterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkBySubmitterRequest.ProtoReflect.Descriptor instead.
+func (*QueryWorkBySubmitterRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryWorkBySubmitterRequest) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *QueryWorkBySubmitterRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryWorkBySubmitterResponse is the response for querying work by submitter
+type QueryWorkBySubmitterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Work is the list of work units submitted by the address
+	Work []*WorkUnit `protobuf:"bytes,1,rep,name=work,proto3" json:"work,omitempty"`
+	// Pagination defines the pagination in the response
+	Pagination    *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkBySubmitterResponse) Reset() {
+	*x = QueryWorkBySubmitterResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkBySubmitterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkBySubmitterResponse) ProtoMessage() {}
+
+func (x *QueryWorkBySubmitterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkBySubmitterResponse.ProtoReflect.Descriptor instead.
+func (*QueryWorkBySubmitterResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryWorkBySubmitterResponse) GetWork() []*WorkUnit {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *QueryWorkBySubmitterResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryWorkVotesRequest is the request for querying the votes on a work unit
+type QueryWorkVotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkVotesRequest) Reset() {
+	*x = QueryWorkVotesRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkVotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkVotesRequest) ProtoMessage() {}
+
+func (x *QueryWorkVotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkVotesRequest.ProtoReflect.Descriptor instead.
+func (*QueryWorkVotesRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryWorkVotesRequest) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+// QueryWorkVotesResponse is the response for querying the votes on a work unit
+type QueryWorkVotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Votes is the list of votes cast on the work unit
+	Votes []*WorkVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	// Tally summarizes the votes against the work type's quorum rule
+	Tally         *VoteTally `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkVotesResponse) Reset() {
+	*x = QueryWorkVotesResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkVotesResponse) ProtoMessage() {}
+
+func (x *QueryWorkVotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkVotesResponse.ProtoReflect.Descriptor instead.
+func (*QueryWorkVotesResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryWorkVotesResponse) GetVotes() []*WorkVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *QueryWorkVotesResponse) GetTally() *VoteTally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+// QueryChallengeRequest is the request for querying a work unit's challenge
+type QueryChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryChallengeRequest) Reset() {
+	*x = QueryChallengeRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChallengeRequest) ProtoMessage() {}
+
+func (x *QueryChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryChallengeRequest.ProtoReflect.Descriptor instead.
+func (*QueryChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryChallengeRequest) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+// QueryChallengeResponse is the response for querying a work unit's challenge
+type QueryChallengeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Challenge is the challenge raised against the work unit
+	Challenge *Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Votes is the list of votes cast in the re-validation round
+	Votes []*WorkVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	// Tally summarizes the re-validation votes against the quorum rule
+	Tally         *VoteTally `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryChallengeResponse) Reset() {
+	*x = QueryChallengeResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChallengeResponse) ProtoMessage() {}
+
+func (x *QueryChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryChallengeResponse.ProtoReflect.Descriptor instead.
+func (*QueryChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryChallengeResponse) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *QueryChallengeResponse) GetVotes() []*WorkVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *QueryChallengeResponse) GetTally() *VoteTally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+// QueryValidationCommitsRequest is the request for querying the unrevealed
+// commitments on a work unit
+type QueryValidationCommitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryValidationCommitsRequest) Reset() {
+	*x = QueryValidationCommitsRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryValidationCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidationCommitsRequest) ProtoMessage() {}
+
+func (x *QueryValidationCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryValidationCommitsRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidationCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryValidationCommitsRequest) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+// QueryValidationCommitsResponse is the response for querying the unrevealed
+// commitments on a work unit
+type QueryValidationCommitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Commits is the list of unrevealed commitments, ordered by validator
+	Commits       []*ValidationCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryValidationCommitsResponse) Reset() {
+	*x = QueryValidationCommitsResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryValidationCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidationCommitsResponse) ProtoMessage() {}
+
+func (x *QueryValidationCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryValidationCommitsResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidationCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryValidationCommitsResponse) GetCommits() []*ValidationCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request for querying the module parameters
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+// QueryParamsResponse is the response for querying the module parameters
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryWorkTypeRequest is the request for querying a work type definition
+type QueryWorkTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkTypeRequest) Reset() {
+	*x = QueryWorkTypeRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkTypeRequest) ProtoMessage() {}
+
+func (x *QueryWorkTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkTypeRequest.ProtoReflect.Descriptor instead.
+func (*QueryWorkTypeRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryWorkTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// QueryWorkTypeResponse is the response for querying a work type definition
+type QueryWorkTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definition    *WorkTypeDefinition    `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkTypeResponse) Reset() {
+	*x = QueryWorkTypeResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkTypeResponse) ProtoMessage() {}
+
+func (x *QueryWorkTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkTypeResponse.ProtoReflect.Descriptor instead.
+func (*QueryWorkTypeResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryWorkTypeResponse) GetDefinition() *WorkTypeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+// QueryWorkTypesRequest is the request for listing work type definitions
+type QueryWorkTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination defines an optional pagination for the request
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkTypesRequest) Reset() {
+	*x = QueryWorkTypesRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkTypesRequest) ProtoMessage() {}
+
+func (x *QueryWorkTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkTypesRequest.ProtoReflect.Descriptor instead.
+func (*QueryWorkTypesRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryWorkTypesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryWorkTypesResponse is the response for listing work type definitions
+type QueryWorkTypesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Definitions is the list of registered work types in name order
+	Definitions []*WorkTypeDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	// Pagination defines the pagination in the response
+	Pagination    *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryWorkTypesResponse) Reset() {
+	*x = QueryWorkTypesResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryWorkTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWorkTypesResponse) ProtoMessage() {}
+
+func (x *QueryWorkTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryWorkTypesResponse.ProtoReflect.Descriptor instead.
+func (*QueryWorkTypesResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryWorkTypesResponse) GetDefinitions() []*WorkTypeDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+func (x *QueryWorkTypesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryBountyRequest is the request for querying a work unit's bounty
+type QueryBountyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryPendingWorkRequest) Reset() {
-	*x = QueryPendingWorkRequest{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[2]
+func (x *QueryBountyRequest) Reset() {
+	*x = QueryBountyRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryPendingWorkRequest) String() string {
+func (x *QueryBountyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingWorkRequest) ProtoMessage() {}
+func (*QueryBountyRequest) ProtoMessage() {}
 
-func (x *QueryPendingWorkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[2]
+func (x *QueryBountyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,35 +1135,134 @@ func (x *QueryPendingWorkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryPendingWorkRequest.ProtoReflect.Descriptor instead.
-func (*QueryPendingWorkRequest) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryBountyRequest.ProtoReflect.Descriptor instead.
+func (*QueryBountyRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-// QueryPendingWorkResponse is the response for querying pending work
-type QueryPendingWorkResponse struct {
+func (x *QueryBountyRequest) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+// QueryBountyResponse is the response for querying a work unit's bounty
+type QueryBountyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bounty        *Bounty                `protobuf:"bytes,1,opt,name=bounty,proto3" json:"bounty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryBountyResponse) Reset() {
+	*x = QueryBountyResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryBountyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBountyResponse) ProtoMessage() {}
+
+func (x *QueryBountyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBountyResponse.ProtoReflect.Descriptor instead.
+func (*QueryBountyResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryBountyResponse) GetBounty() *Bounty {
+	if x != nil {
+		return x.Bounty
+	}
+	return nil
+}
+
+// QueryValidatorBondRequest is the request for querying a validator's bond
+type QueryValidatorBondRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorAddress string                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QueryValidatorBondRequest) Reset() {
+	*x = QueryValidatorBondRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryValidatorBondRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorBondRequest) ProtoMessage() {}
+
+func (x *QueryValidatorBondRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryValidatorBondRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorBondRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryValidatorBondRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorBondResponse is the response for querying a validator's bond
+type QueryValidatorBondResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// PendingWork is the list of pending work units
-	PendingWork   []*WorkUnit `protobuf:"bytes,1,rep,name=pending_work,json=pendingWork,proto3" json:"pending_work,omitempty"`
+	// Bond is the validator's bonded stake
+	Bond *ValidatorBond `protobuf:"bytes,1,opt,name=bond,proto3" json:"bond,omitempty"`
+	// Unbonding is the list of the validator's unbonding entries
+	Unbonding     []*UnbondingEntry `protobuf:"bytes,2,rep,name=unbonding,proto3" json:"unbonding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryPendingWorkResponse) Reset() {
-	*x = QueryPendingWorkResponse{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[3]
+func (x *QueryValidatorBondResponse) Reset() {
+	*x = QueryValidatorBondResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryPendingWorkResponse) String() string {
+func (x *QueryValidatorBondResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingWorkResponse) ProtoMessage() {}
+func (*QueryValidatorBondResponse) ProtoMessage() {}
 
-func (x *QueryPendingWorkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[3]
+func (x *QueryValidatorBondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,14 +1273,21 @@ func (x *QueryPendingWorkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryPendingWorkResponse.ProtoReflect.Descriptor instead.
-func (*QueryPendingWorkResponse) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryValidatorBondResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorBondResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryPendingWorkResponse) GetPendingWork() []*WorkUnit {
+func (x *QueryValidatorBondResponse) GetBond() *ValidatorBond {
 	if x != nil {
-		return x.PendingWork
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *QueryValidatorBondResponse) GetUnbonding() []*UnbondingEntry {
+	if x != nil {
+		return x.Unbonding
 	}
 	return nil
 }
@@ -205,7 +1302,7 @@ type QueryValidatorStatsRequest struct {
 
 func (x *QueryValidatorStatsRequest) Reset() {
 	*x = QueryValidatorStatsRequest{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[4]
+	mi := &file_workqueue_v1_query_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -217,7 +1314,7 @@ func (x *QueryValidatorStatsRequest) String() string {
 func (*QueryValidatorStatsRequest) ProtoMessage() {}
 
 func (x *QueryValidatorStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[4]
+	mi := &file_workqueue_v1_query_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +1327,7 @@ func (x *QueryValidatorStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryValidatorStatsRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorStatsRequest) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryValidatorStatsRequest) GetValidatorAddress() string {
@@ -251,7 +1348,7 @@ type QueryValidatorStatsResponse struct {
 
 func (x *QueryValidatorStatsResponse) Reset() {
 	*x = QueryValidatorStatsResponse{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[5]
+	mi := &file_workqueue_v1_query_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -263,7 +1360,7 @@ func (x *QueryValidatorStatsResponse) String() string {
 func (*QueryValidatorStatsResponse) ProtoMessage() {}
 
 func (x *QueryValidatorStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[5]
+	mi := &file_workqueue_v1_query_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +1373,7 @@ func (x *QueryValidatorStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryValidatorStatsResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorStatsResponse) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryValidatorStatsResponse) GetStats() *ValidatorStats {
@@ -295,7 +1392,7 @@ type QueryTotalStatsRequest struct {
 
 func (x *QueryTotalStatsRequest) Reset() {
 	*x = QueryTotalStatsRequest{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[6]
+	mi := &file_workqueue_v1_query_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +1404,7 @@ func (x *QueryTotalStatsRequest) String() string {
 func (*QueryTotalStatsRequest) ProtoMessage() {}
 
 func (x *QueryTotalStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[6]
+	mi := &file_workqueue_v1_query_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +1417,7 @@ func (x *QueryTotalStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTotalStatsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalStatsRequest) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 // QueryTotalStatsResponse is the response for querying total statistics
@@ -338,7 +1435,7 @@ type QueryTotalStatsResponse struct {
 
 func (x *QueryTotalStatsResponse) Reset() {
 	*x = QueryTotalStatsResponse{}
-	mi := &file_workqueue_v1_query_proto_msgTypes[7]
+	mi := &file_workqueue_v1_query_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +1447,7 @@ func (x *QueryTotalStatsResponse) String() string {
 func (*QueryTotalStatsResponse) ProtoMessage() {}
 
 func (x *QueryTotalStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_query_proto_msgTypes[7]
+	mi := &file_workqueue_v1_query_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +1460,7 @@ func (x *QueryTotalStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTotalStatsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalStatsResponse) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryTotalStatsResponse) GetTotalSubmitted() uint64 {
@@ -387,18 +1484,242 @@ func (x *QueryTotalStatsResponse) GetTotalRejected() uint64 {
 	return 0
 }
 
+// QueryCheckInvariantsRequest is the request for running the module invariants
+type QueryCheckInvariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCheckInvariantsRequest) Reset() {
+	*x = QueryCheckInvariantsRequest{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCheckInvariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCheckInvariantsRequest) ProtoMessage() {}
+
+func (x *QueryCheckInvariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCheckInvariantsRequest.ProtoReflect.Descriptor instead.
+func (*QueryCheckInvariantsRequest) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{29}
+}
+
+// InvariantResult is the outcome of a single invariant
+type InvariantResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Route is the invariant's route within the module
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Broken indicates the state violates the invariant
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// Message describes the checked state and any violations
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvariantResult) Reset() {
+	*x = InvariantResult{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvariantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvariantResult) ProtoMessage() {}
+
+func (x *InvariantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvariantResult.ProtoReflect.Descriptor instead.
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InvariantResult) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *InvariantResult) GetBroken() bool {
+	if x != nil {
+		return x.Broken
+	}
+	return false
+}
+
+func (x *InvariantResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// QueryCheckInvariantsResponse is the response for running the module
+// invariants
+type QueryCheckInvariantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invariants contains the outcome of every module invariant
+	Invariants    []*InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCheckInvariantsResponse) Reset() {
+	*x = QueryCheckInvariantsResponse{}
+	mi := &file_workqueue_v1_query_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCheckInvariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCheckInvariantsResponse) ProtoMessage() {}
+
+func (x *QueryCheckInvariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_query_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCheckInvariantsResponse.ProtoReflect.Descriptor instead.
+func (*QueryCheckInvariantsResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryCheckInvariantsResponse) GetInvariants() []*InvariantResult {
+	if x != nil {
+		return x.Invariants
+	}
+	return nil
+}
+
 var File_workqueue_v1_query_proto protoreflect.FileDescriptor
 
 const file_workqueue_v1_query_proto_rawDesc = "" +
 	"\n" +
-	"\x18workqueue/v1/query.proto\x12\x13pickle.workqueue.v1\x1a\x1cworkqueue/v1/workqueue.proto\"+\n" +
+	"\x18workqueue/v1/query.proto\x12\x13pickle.workqueue.v1\x1a*cosmos/base/query/v1beta1/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cworkqueue/v1/workqueue.proto\"+\n" +
 	"\x10QueryWorkRequest\x12\x17\n" +
 	"\awork_id\x18\x01 \x01(\tR\x06workId\"F\n" +
 	"\x11QueryWorkResponse\x121\n" +
-	"\x04work\x18\x01 \x01(\v2\x1d.pickle.workqueue.v1.WorkUnitR\x04work\"\x19\n" +
-	"\x17QueryPendingWorkRequest\"\\\n" +
+	"\x04work\x18\x01 \x01(\v2\x1d.pickle.workqueue.v1.WorkUnitR\x04work\"\xb3\x01\n" +
+	"\n" +
+	"WorkFilter\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\twork_type\x18\x02 \x01(\tR\bworkType\x12\x1c\n" +
+	"\tsubmitter\x18\x03 \x01(\tR\tsubmitter\x12(\n" +
+	"\x10min_submitted_at\x18\x04 \x01(\x03R\x0eminSubmittedAt\x12(\n" +
+	"\x10max_submitted_at\x18\x05 \x01(\x03R\x0emaxSubmittedAt\"\x9a\x01\n" +
+	"\x17QueryPendingWorkRequest\x127\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.pickle.workqueue.v1.WorkFilterR\x06filter\x12F\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\xa5\x01\n" +
 	"\x18QueryPendingWorkResponse\x12@\n" +
-	"\fpending_work\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\vpendingWork\"I\n" +
+	"\fpending_work\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\vpendingWork\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination\"\x97\x01\n" +
+	"\x14QueryListWorkRequest\x127\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.pickle.workqueue.v1.WorkFilterR\x06filter\x12F\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\x93\x01\n" +
+	"\x15QueryListWorkResponse\x121\n" +
+	"\x04work\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\x04work\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination\"\x83\x01\n" +
+	"\x1bQueryWorkBySubmitterRequest\x12\x1c\n" +
+	"\tsubmitter\x18\x01 \x01(\tR\tsubmitter\x12F\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\x9a\x01\n" +
+	"\x1cQueryWorkBySubmitterResponse\x121\n" +
+	"\x04work\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\x04work\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination\"0\n" +
+	"\x15QueryWorkVotesRequest\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\"\x83\x01\n" +
+	"\x16QueryWorkVotesResponse\x123\n" +
+	"\x05votes\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkVoteR\x05votes\x124\n" +
+	"\x05tally\x18\x02 \x01(\v2\x1e.pickle.workqueue.v1.VoteTallyR\x05tally\"0\n" +
+	"\x15QueryChallengeRequest\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\"\xc1\x01\n" +
+	"\x16QueryChallengeResponse\x12<\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x1e.pickle.workqueue.v1.ChallengeR\tchallenge\x123\n" +
+	"\x05votes\x18\x02 \x03(\v2\x1d.pickle.workqueue.v1.WorkVoteR\x05votes\x124\n" +
+	"\x05tally\x18\x03 \x01(\v2\x1e.pickle.workqueue.v1.VoteTallyR\x05tally\"8\n" +
+	"\x1dQueryValidationCommitsRequest\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\"a\n" +
+	"\x1eQueryValidationCommitsResponse\x12?\n" +
+	"\acommits\x18\x01 \x03(\v2%.pickle.workqueue.v1.ValidationCommitR\acommits\"\x14\n" +
+	"\x12QueryParamsRequest\"J\n" +
+	"\x13QueryParamsResponse\x123\n" +
+	"\x06params\x18\x01 \x01(\v2\x1b.pickle.workqueue.v1.ParamsR\x06params\"*\n" +
+	"\x14QueryWorkTypeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"`\n" +
+	"\x15QueryWorkTypeResponse\x12G\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2'.pickle.workqueue.v1.WorkTypeDefinitionR\n" +
+	"definition\"_\n" +
+	"\x15QueryWorkTypesRequest\x12F\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2&.cosmos.base.query.v1beta1.PageRequestR\n" +
+	"pagination\"\xac\x01\n" +
+	"\x16QueryWorkTypesResponse\x12I\n" +
+	"\vdefinitions\x18\x01 \x03(\v2'.pickle.workqueue.v1.WorkTypeDefinitionR\vdefinitions\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.cosmos.base.query.v1beta1.PageResponseR\n" +
+	"pagination\"-\n" +
+	"\x12QueryBountyRequest\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\"J\n" +
+	"\x13QueryBountyResponse\x123\n" +
+	"\x06bounty\x18\x01 \x01(\v2\x1b.pickle.workqueue.v1.BountyR\x06bounty\"H\n" +
+	"\x19QueryValidatorBondRequest\x12+\n" +
+	"\x11validator_address\x18\x01 \x01(\tR\x10validatorAddress\"\x97\x01\n" +
+	"\x1aQueryValidatorBondResponse\x126\n" +
+	"\x04bond\x18\x01 \x01(\v2\".pickle.workqueue.v1.ValidatorBondR\x04bond\x12A\n" +
+	"\tunbonding\x18\x02 \x03(\v2#.pickle.workqueue.v1.UnbondingEntryR\tunbonding\"I\n" +
 	"\x1aQueryValidatorStatsRequest\x12+\n" +
 	"\x11validator_address\x18\x01 \x01(\tR\x10validatorAddress\"X\n" +
 	"\x1bQueryValidatorStatsResponse\x129\n" +
@@ -407,13 +1728,33 @@ const file_workqueue_v1_query_proto_rawDesc = "" +
 	"\x17QueryTotalStatsResponse\x12'\n" +
 	"\x0ftotal_submitted\x18\x01 \x01(\x04R\x0etotalSubmitted\x12'\n" +
 	"\x0ftotal_validated\x18\x02 \x01(\x04R\x0etotalValidated\x12%\n" +
-	"\x0etotal_rejected\x18\x03 \x01(\x04R\rtotalRejected2\xa8\x03\n" +
-	"\x05Query\x12U\n" +
-	"\x04Work\x12%.pickle.workqueue.v1.QueryWorkRequest\x1a&.pickle.workqueue.v1.QueryWorkResponse\x12j\n" +
-	"\vPendingWork\x12,.pickle.workqueue.v1.QueryPendingWorkRequest\x1a-.pickle.workqueue.v1.QueryPendingWorkResponse\x12s\n" +
-	"\x0eValidatorStats\x12/.pickle.workqueue.v1.QueryValidatorStatsRequest\x1a0.pickle.workqueue.v1.QueryValidatorStatsResponse\x12g\n" +
+	"\x0etotal_rejected\x18\x03 \x01(\x04R\rtotalRejected\"\x1d\n" +
+	"\x1bQueryCheckInvariantsRequest\"Y\n" +
+	"\x0fInvariantResult\x12\x14\n" +
+	"\x05route\x18\x01 \x01(\tR\x05route\x12\x16\n" +
+	"\x06broken\x18\x02 \x01(\bR\x06broken\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"d\n" +
+	"\x1cQueryCheckInvariantsResponse\x12D\n" +
+	"\n" +
+	"invariants\x18\x01 \x03(\v2$.pickle.workqueue.v1.InvariantResultR\n" +
+	"invariants2\xa1\x12\n" +
+	"\x05Query\x12\x82\x01\n" +
+	"\x04Work\x12%.pickle.workqueue.v1.QueryWorkRequest\x1a&.pickle.workqueue.v1.QueryWorkResponse\"+\x82\xd3\xe4\x93\x02%\x12#/pickle/workqueue/v1/work/{work_id}\x12\x95\x01\n" +
+	"\vPendingWork\x12,.pickle.workqueue.v1.QueryPendingWorkRequest\x1a-.pickle.workqueue.v1.QueryPendingWorkResponse\")\x82\xd3\xe4\x93\x02#\x12!/pickle/workqueue/v1/pending_work\x12\x84\x01\n" +
+	"\bListWork\x12).pickle.workqueue.v1.QueryListWorkRequest\x1a*.pickle.workqueue.v1.QueryListWorkResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/pickle/workqueue/v1/work\x12\xb0\x01\n" +
+	"\x0fWorkBySubmitter\x120.pickle.workqueue.v1.QueryWorkBySubmitterRequest\x1a1.pickle.workqueue.v1.QueryWorkBySubmitterResponse\"8\x82\xd3\xe4\x93\x022\x120/pickle/workqueue/v1/submitters/{submitter}/work\x12\x97\x01\n" +
+	"\tWorkVotes\x12*.pickle.workqueue.v1.QueryWorkVotesRequest\x1a+.pickle.workqueue.v1.QueryWorkVotesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/pickle/workqueue/v1/work/{work_id}/votes\x12\xb1\x01\n" +
+	"\x11ValidationCommits\x122.pickle.workqueue.v1.QueryValidationCommitsRequest\x1a3.pickle.workqueue.v1.QueryValidationCommitsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/pickle/workqueue/v1/work/{work_id}/commits\x12\x9b\x01\n" +
+	"\tChallenge\x12*.pickle.workqueue.v1.QueryChallengeRequest\x1a+.pickle.workqueue.v1.QueryChallengeResponse\"5\x82\xd3\xe4\x93\x02/\x12-/pickle/workqueue/v1/work/{work_id}/challenge\x12\x80\x01\n" +
+	"\x06Params\x12'.pickle.workqueue.v1.QueryParamsRequest\x1a(.pickle.workqueue.v1.QueryParamsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/pickle/workqueue/v1/params\x12\x91\x01\n" +
+	"\bWorkType\x12).pickle.workqueue.v1.QueryWorkTypeRequest\x1a*.pickle.workqueue.v1.QueryWorkTypeResponse\".\x82\xd3\xe4\x93\x02(\x12&/pickle/workqueue/v1/work_types/{name}\x12\x8d\x01\n" +
+	"\tWorkTypes\x12*.pickle.workqueue.v1.QueryWorkTypesRequest\x1a+.pickle.workqueue.v1.QueryWorkTypesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/pickle/workqueue/v1/work_types\x12\x8f\x01\n" +
+	"\x06Bounty\x12'.pickle.workqueue.v1.QueryBountyRequest\x1a(.pickle.workqueue.v1.QueryBountyResponse\"2\x82\xd3\xe4\x93\x02,\x12*/pickle/workqueue/v1/work/{work_id}/bounty\x12\xb2\x01\n" +
+	"\rValidatorBond\x12..pickle.workqueue.v1.QueryValidatorBondRequest\x1a/.pickle.workqueue.v1.QueryValidatorBondResponse\"@\x82\xd3\xe4\x93\x02:\x128/pickle/workqueue/v1/validators/{validator_address}/bond\x12\xb6\x01\n" +
+	"\x0eValidatorStats\x12/.pickle.workqueue.v1.QueryValidatorStatsRequest\x1a0.pickle.workqueue.v1.QueryValidatorStatsResponse\"A\x82\xd3\xe4\x93\x02;\x129/pickle/workqueue/v1/validators/{validator_address}/stats\x12\x8b\x01\n" +
 	"\n" +
-	"TotalStats\x12+.pickle.workqueue.v1.QueryTotalStatsRequest\x1a,.pickle.workqueue.v1.QueryTotalStatsResponseB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
+	"TotalStats\x12+.pickle.workqueue.v1.QueryTotalStatsRequest\x1a,.pickle.workqueue.v1.QueryTotalStatsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/pickle/workqueue/v1/stats\x12\x9f\x01\n" +
+	"\x0fCheckInvariants\x120.pickle.workqueue.v1.QueryCheckInvariantsRequest\x1a1.pickle.workqueue.v1.QueryCheckInvariantsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/pickle/workqueue/v1/invariantsB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
 
 var (
 	file_workqueue_v1_query_proto_rawDescOnce sync.Once
@@ -427,36 +1768,118 @@ func file_workqueue_v1_query_proto_rawDescGZIP() []byte {
 	return file_workqueue_v1_query_proto_rawDescData
 }
 
-var file_workqueue_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_workqueue_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_workqueue_v1_query_proto_goTypes = []any{
-	(*QueryWorkRequest)(nil),            // 0: pickle.workqueue.v1.QueryWorkRequest
-	(*QueryWorkResponse)(nil),           // 1: pickle.workqueue.v1.QueryWorkResponse
-	(*QueryPendingWorkRequest)(nil),     // 2: pickle.workqueue.v1.QueryPendingWorkRequest
-	(*QueryPendingWorkResponse)(nil),    // 3: pickle.workqueue.v1.QueryPendingWorkResponse
-	(*QueryValidatorStatsRequest)(nil),  // 4: pickle.workqueue.v1.QueryValidatorStatsRequest
-	(*QueryValidatorStatsResponse)(nil), // 5: pickle.workqueue.v1.QueryValidatorStatsResponse
-	(*QueryTotalStatsRequest)(nil),      // 6: pickle.workqueue.v1.QueryTotalStatsRequest
-	(*QueryTotalStatsResponse)(nil),     // 7: pickle.workqueue.v1.QueryTotalStatsResponse
-	(*WorkUnit)(nil),                    // 8: pickle.workqueue.v1.WorkUnit
-	(*ValidatorStats)(nil),              // 9: pickle.workqueue.v1.ValidatorStats
+	(*QueryWorkRequest)(nil),               // 0: pickle.workqueue.v1.QueryWorkRequest
+	(*QueryWorkResponse)(nil),              // 1: pickle.workqueue.v1.QueryWorkResponse
+	(*WorkFilter)(nil),                     // 2: pickle.workqueue.v1.WorkFilter
+	(*QueryPendingWorkRequest)(nil),        // 3: pickle.workqueue.v1.QueryPendingWorkRequest
+	(*QueryPendingWorkResponse)(nil),       // 4: pickle.workqueue.v1.QueryPendingWorkResponse
+	(*QueryListWorkRequest)(nil),           // 5: pickle.workqueue.v1.QueryListWorkRequest
+	(*QueryListWorkResponse)(nil),          // 6: pickle.workqueue.v1.QueryListWorkResponse
+	(*QueryWorkBySubmitterRequest)(nil),    // 7: pickle.workqueue.v1.QueryWorkBySubmitterRequest
+	(*QueryWorkBySubmitterResponse)(nil),   // 8: pickle.workqueue.v1.QueryWorkBySubmitterResponse
+	(*QueryWorkVotesRequest)(nil),          // 9: pickle.workqueue.v1.QueryWorkVotesRequest
+	(*QueryWorkVotesResponse)(nil),         // 10: pickle.workqueue.v1.QueryWorkVotesResponse
+	(*QueryChallengeRequest)(nil),          // 11: pickle.workqueue.v1.QueryChallengeRequest
+	(*QueryChallengeResponse)(nil),         // 12: pickle.workqueue.v1.QueryChallengeResponse
+	(*QueryValidationCommitsRequest)(nil),  // 13: pickle.workqueue.v1.QueryValidationCommitsRequest
+	(*QueryValidationCommitsResponse)(nil), // 14: pickle.workqueue.v1.QueryValidationCommitsResponse
+	(*QueryParamsRequest)(nil),             // 15: pickle.workqueue.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 16: pickle.workqueue.v1.QueryParamsResponse
+	(*QueryWorkTypeRequest)(nil),           // 17: pickle.workqueue.v1.QueryWorkTypeRequest
+	(*QueryWorkTypeResponse)(nil),          // 18: pickle.workqueue.v1.QueryWorkTypeResponse
+	(*QueryWorkTypesRequest)(nil),          // 19: pickle.workqueue.v1.QueryWorkTypesRequest
+	(*QueryWorkTypesResponse)(nil),         // 20: pickle.workqueue.v1.QueryWorkTypesResponse
+	(*QueryBountyRequest)(nil),             // 21: pickle.workqueue.v1.QueryBountyRequest
+	(*QueryBountyResponse)(nil),            // 22: pickle.workqueue.v1.QueryBountyResponse
+	(*QueryValidatorBondRequest)(nil),      // 23: pickle.workqueue.v1.QueryValidatorBondRequest
+	(*QueryValidatorBondResponse)(nil),     // 24: pickle.workqueue.v1.QueryValidatorBondResponse
+	(*QueryValidatorStatsRequest)(nil),     // 25: pickle.workqueue.v1.QueryValidatorStatsRequest
+	(*QueryValidatorStatsResponse)(nil),    // 26: pickle.workqueue.v1.QueryValidatorStatsResponse
+	(*QueryTotalStatsRequest)(nil),         // 27: pickle.workqueue.v1.QueryTotalStatsRequest
+	(*QueryTotalStatsResponse)(nil),        // 28: pickle.workqueue.v1.QueryTotalStatsResponse
+	(*QueryCheckInvariantsRequest)(nil),    // 29: pickle.workqueue.v1.QueryCheckInvariantsRequest
+	(*InvariantResult)(nil),                // 30: pickle.workqueue.v1.InvariantResult
+	(*QueryCheckInvariantsResponse)(nil),   // 31: pickle.workqueue.v1.QueryCheckInvariantsResponse
+	(*WorkUnit)(nil),                       // 32: pickle.workqueue.v1.WorkUnit
+	(*v1beta1.PageRequest)(nil),            // 33: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),           // 34: cosmos.base.query.v1beta1.PageResponse
+	(*WorkVote)(nil),                       // 35: pickle.workqueue.v1.WorkVote
+	(*VoteTally)(nil),                      // 36: pickle.workqueue.v1.VoteTally
+	(*Challenge)(nil),                      // 37: pickle.workqueue.v1.Challenge
+	(*ValidationCommit)(nil),               // 38: pickle.workqueue.v1.ValidationCommit
+	(*Params)(nil),                         // 39: pickle.workqueue.v1.Params
+	(*WorkTypeDefinition)(nil),             // 40: pickle.workqueue.v1.WorkTypeDefinition
+	(*Bounty)(nil),                         // 41: pickle.workqueue.v1.Bounty
+	(*ValidatorBond)(nil),                  // 42: pickle.workqueue.v1.ValidatorBond
+	(*UnbondingEntry)(nil),                 // 43: pickle.workqueue.v1.UnbondingEntry
+	(*ValidatorStats)(nil),                 // 44: pickle.workqueue.v1.ValidatorStats
 }
 var file_workqueue_v1_query_proto_depIdxs = []int32{
-	8, // 0: pickle.workqueue.v1.QueryWorkResponse.work:type_name -> pickle.workqueue.v1.WorkUnit
-	8, // 1: pickle.workqueue.v1.QueryPendingWorkResponse.pending_work:type_name -> pickle.workqueue.v1.WorkUnit
-	9, // 2: pickle.workqueue.v1.QueryValidatorStatsResponse.stats:type_name -> pickle.workqueue.v1.ValidatorStats
-	0, // 3: pickle.workqueue.v1.Query.Work:input_type -> pickle.workqueue.v1.QueryWorkRequest
-	2, // 4: pickle.workqueue.v1.Query.PendingWork:input_type -> pickle.workqueue.v1.QueryPendingWorkRequest
-	4, // 5: pickle.workqueue.v1.Query.ValidatorStats:input_type -> pickle.workqueue.v1.QueryValidatorStatsRequest
-	6, // 6: pickle.workqueue.v1.Query.TotalStats:input_type -> pickle.workqueue.v1.QueryTotalStatsRequest
-	1, // 7: pickle.workqueue.v1.Query.Work:output_type -> pickle.workqueue.v1.QueryWorkResponse
-	3, // 8: pickle.workqueue.v1.Query.PendingWork:output_type -> pickle.workqueue.v1.QueryPendingWorkResponse
-	5, // 9: pickle.workqueue.v1.Query.ValidatorStats:output_type -> pickle.workqueue.v1.QueryValidatorStatsResponse
-	7, // 10: pickle.workqueue.v1.Query.TotalStats:output_type -> pickle.workqueue.v1.QueryTotalStatsResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	32, // 0: pickle.workqueue.v1.QueryWorkResponse.work:type_name -> pickle.workqueue.v1.WorkUnit
+	2,  // 1: pickle.workqueue.v1.QueryPendingWorkRequest.filter:type_name -> pickle.workqueue.v1.WorkFilter
+	33, // 2: pickle.workqueue.v1.QueryPendingWorkRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 3: pickle.workqueue.v1.QueryPendingWorkResponse.pending_work:type_name -> pickle.workqueue.v1.WorkUnit
+	34, // 4: pickle.workqueue.v1.QueryPendingWorkResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	2,  // 5: pickle.workqueue.v1.QueryListWorkRequest.filter:type_name -> pickle.workqueue.v1.WorkFilter
+	33, // 6: pickle.workqueue.v1.QueryListWorkRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 7: pickle.workqueue.v1.QueryListWorkResponse.work:type_name -> pickle.workqueue.v1.WorkUnit
+	34, // 8: pickle.workqueue.v1.QueryListWorkResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	33, // 9: pickle.workqueue.v1.QueryWorkBySubmitterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 10: pickle.workqueue.v1.QueryWorkBySubmitterResponse.work:type_name -> pickle.workqueue.v1.WorkUnit
+	34, // 11: pickle.workqueue.v1.QueryWorkBySubmitterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	35, // 12: pickle.workqueue.v1.QueryWorkVotesResponse.votes:type_name -> pickle.workqueue.v1.WorkVote
+	36, // 13: pickle.workqueue.v1.QueryWorkVotesResponse.tally:type_name -> pickle.workqueue.v1.VoteTally
+	37, // 14: pickle.workqueue.v1.QueryChallengeResponse.challenge:type_name -> pickle.workqueue.v1.Challenge
+	35, // 15: pickle.workqueue.v1.QueryChallengeResponse.votes:type_name -> pickle.workqueue.v1.WorkVote
+	36, // 16: pickle.workqueue.v1.QueryChallengeResponse.tally:type_name -> pickle.workqueue.v1.VoteTally
+	38, // 17: pickle.workqueue.v1.QueryValidationCommitsResponse.commits:type_name -> pickle.workqueue.v1.ValidationCommit
+	39, // 18: pickle.workqueue.v1.QueryParamsResponse.params:type_name -> pickle.workqueue.v1.Params
+	40, // 19: pickle.workqueue.v1.QueryWorkTypeResponse.definition:type_name -> pickle.workqueue.v1.WorkTypeDefinition
+	33, // 20: pickle.workqueue.v1.QueryWorkTypesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	40, // 21: pickle.workqueue.v1.QueryWorkTypesResponse.definitions:type_name -> pickle.workqueue.v1.WorkTypeDefinition
+	34, // 22: pickle.workqueue.v1.QueryWorkTypesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	41, // 23: pickle.workqueue.v1.QueryBountyResponse.bounty:type_name -> pickle.workqueue.v1.Bounty
+	42, // 24: pickle.workqueue.v1.QueryValidatorBondResponse.bond:type_name -> pickle.workqueue.v1.ValidatorBond
+	43, // 25: pickle.workqueue.v1.QueryValidatorBondResponse.unbonding:type_name -> pickle.workqueue.v1.UnbondingEntry
+	44, // 26: pickle.workqueue.v1.QueryValidatorStatsResponse.stats:type_name -> pickle.workqueue.v1.ValidatorStats
+	30, // 27: pickle.workqueue.v1.QueryCheckInvariantsResponse.invariants:type_name -> pickle.workqueue.v1.InvariantResult
+	0,  // 28: pickle.workqueue.v1.Query.Work:input_type -> pickle.workqueue.v1.QueryWorkRequest
+	3,  // 29: pickle.workqueue.v1.Query.PendingWork:input_type -> pickle.workqueue.v1.QueryPendingWorkRequest
+	5,  // 30: pickle.workqueue.v1.Query.ListWork:input_type -> pickle.workqueue.v1.QueryListWorkRequest
+	7,  // 31: pickle.workqueue.v1.Query.WorkBySubmitter:input_type -> pickle.workqueue.v1.QueryWorkBySubmitterRequest
+	9,  // 32: pickle.workqueue.v1.Query.WorkVotes:input_type -> pickle.workqueue.v1.QueryWorkVotesRequest
+	13, // 33: pickle.workqueue.v1.Query.ValidationCommits:input_type -> pickle.workqueue.v1.QueryValidationCommitsRequest
+	11, // 34: pickle.workqueue.v1.Query.Challenge:input_type -> pickle.workqueue.v1.QueryChallengeRequest
+	15, // 35: pickle.workqueue.v1.Query.Params:input_type -> pickle.workqueue.v1.QueryParamsRequest
+	17, // 36: pickle.workqueue.v1.Query.WorkType:input_type -> pickle.workqueue.v1.QueryWorkTypeRequest
+	19, // 37: pickle.workqueue.v1.Query.WorkTypes:input_type -> pickle.workqueue.v1.QueryWorkTypesRequest
+	21, // 38: pickle.workqueue.v1.Query.Bounty:input_type -> pickle.workqueue.v1.QueryBountyRequest
+	23, // 39: pickle.workqueue.v1.Query.ValidatorBond:input_type -> pickle.workqueue.v1.QueryValidatorBondRequest
+	25, // 40: pickle.workqueue.v1.Query.ValidatorStats:input_type -> pickle.workqueue.v1.QueryValidatorStatsRequest
+	27, // 41: pickle.workqueue.v1.Query.TotalStats:input_type -> pickle.workqueue.v1.QueryTotalStatsRequest
+	29, // 42: pickle.workqueue.v1.Query.CheckInvariants:input_type -> pickle.workqueue.v1.QueryCheckInvariantsRequest
+	1,  // 43: pickle.workqueue.v1.Query.Work:output_type -> pickle.workqueue.v1.QueryWorkResponse
+	4,  // 44: pickle.workqueue.v1.Query.PendingWork:output_type -> pickle.workqueue.v1.QueryPendingWorkResponse
+	6,  // 45: pickle.workqueue.v1.Query.ListWork:output_type -> pickle.workqueue.v1.QueryListWorkResponse
+	8,  // 46: pickle.workqueue.v1.Query.WorkBySubmitter:output_type -> pickle.workqueue.v1.QueryWorkBySubmitterResponse
+	10, // 47: pickle.workqueue.v1.Query.WorkVotes:output_type -> pickle.workqueue.v1.QueryWorkVotesResponse
+	14, // 48: pickle.workqueue.v1.Query.ValidationCommits:output_type -> pickle.workqueue.v1.QueryValidationCommitsResponse
+	12, // 49: pickle.workqueue.v1.Query.Challenge:output_type -> pickle.workqueue.v1.QueryChallengeResponse
+	16, // 50: pickle.workqueue.v1.Query.Params:output_type -> pickle.workqueue.v1.QueryParamsResponse
+	18, // 51: pickle.workqueue.v1.Query.WorkType:output_type -> pickle.workqueue.v1.QueryWorkTypeResponse
+	20, // 52: pickle.workqueue.v1.Query.WorkTypes:output_type -> pickle.workqueue.v1.QueryWorkTypesResponse
+	22, // 53: pickle.workqueue.v1.Query.Bounty:output_type -> pickle.workqueue.v1.QueryBountyResponse
+	24, // 54: pickle.workqueue.v1.Query.ValidatorBond:output_type -> pickle.workqueue.v1.QueryValidatorBondResponse
+	26, // 55: pickle.workqueue.v1.Query.ValidatorStats:output_type -> pickle.workqueue.v1.QueryValidatorStatsResponse
+	28, // 56: pickle.workqueue.v1.Query.TotalStats:output_type -> pickle.workqueue.v1.QueryTotalStatsResponse
+	31, // 57: pickle.workqueue.v1.Query.CheckInvariants:output_type -> pickle.workqueue.v1.QueryCheckInvariantsResponse
+	43, // [43:58] is the sub-list for method output_type
+	28, // [28:43] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_workqueue_v1_query_proto_init() }
@@ -471,7 +1894,7 @@ func file_workqueue_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workqueue_v1_query_proto_rawDesc), len(file_workqueue_v1_query_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
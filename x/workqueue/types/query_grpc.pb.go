@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Work_FullMethodName           = "/pickle.workqueue.v1.Query/Work"
-	Query_PendingWork_FullMethodName    = "/pickle.workqueue.v1.Query/PendingWork"
-	Query_ValidatorStats_FullMethodName = "/pickle.workqueue.v1.Query/ValidatorStats"
-	Query_TotalStats_FullMethodName     = "/pickle.workqueue.v1.Query/TotalStats"
+	Query_Work_FullMethodName              = "/pickle.workqueue.v1.Query/Work"
+	Query_PendingWork_FullMethodName       = "/pickle.workqueue.v1.Query/PendingWork"
+	Query_ListWork_FullMethodName          = "/pickle.workqueue.v1.Query/ListWork"
+	Query_WorkBySubmitter_FullMethodName   = "/pickle.workqueue.v1.Query/WorkBySubmitter"
+	Query_WorkVotes_FullMethodName         = "/pickle.workqueue.v1.Query/WorkVotes"
+	Query_ValidationCommits_FullMethodName = "/pickle.workqueue.v1.Query/ValidationCommits"
+	Query_Challenge_FullMethodName         = "/pickle.workqueue.v1.Query/Challenge"
+	Query_Params_FullMethodName            = "/pickle.workqueue.v1.Query/Params"
+	Query_WorkType_FullMethodName          = "/pickle.workqueue.v1.Query/WorkType"
+	Query_WorkTypes_FullMethodName         = "/pickle.workqueue.v1.Query/WorkTypes"
+	Query_Bounty_FullMethodName            = "/pickle.workqueue.v1.Query/Bounty"
+	Query_ValidatorBond_FullMethodName     = "/pickle.workqueue.v1.Query/ValidatorBond"
+	Query_ValidatorStats_FullMethodName    = "/pickle.workqueue.v1.Query/ValidatorStats"
+	Query_TotalStats_FullMethodName        = "/pickle.workqueue.v1.Query/TotalStats"
+	Query_CheckInvariants_FullMethodName   = "/pickle.workqueue.v1.Query/CheckInvariants"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,10 +46,34 @@ type QueryClient interface {
 	Work(ctx context.Context, in *QueryWorkRequest, opts ...grpc.CallOption) (*QueryWorkResponse, error)
 	// PendingWork queries for pending work units
 	PendingWork(ctx context.Context, in *QueryPendingWorkRequest, opts ...grpc.CallOption) (*QueryPendingWorkResponse, error)
+	// ListWork queries work units in any status matching a filter
+	ListWork(ctx context.Context, in *QueryListWorkRequest, opts ...grpc.CallOption) (*QueryListWorkResponse, error)
+	// WorkBySubmitter queries work units submitted by an address
+	WorkBySubmitter(ctx context.Context, in *QueryWorkBySubmitterRequest, opts ...grpc.CallOption) (*QueryWorkBySubmitterResponse, error)
+	// WorkVotes queries the votes cast on a work unit and their tally
+	WorkVotes(ctx context.Context, in *QueryWorkVotesRequest, opts ...grpc.CallOption) (*QueryWorkVotesResponse, error)
+	// ValidationCommits queries the unrevealed commitments on a work unit
+	ValidationCommits(ctx context.Context, in *QueryValidationCommitsRequest, opts ...grpc.CallOption) (*QueryValidationCommitsResponse, error)
+	// Challenge queries the challenge raised against a work unit
+	Challenge(ctx context.Context, in *QueryChallengeRequest, opts ...grpc.CallOption) (*QueryChallengeResponse, error)
+	// Params queries the module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// WorkType queries a registered work type definition
+	WorkType(ctx context.Context, in *QueryWorkTypeRequest, opts ...grpc.CallOption) (*QueryWorkTypeResponse, error)
+	// WorkTypes lists the registered work type definitions
+	WorkTypes(ctx context.Context, in *QueryWorkTypesRequest, opts ...grpc.CallOption) (*QueryWorkTypesResponse, error)
+	// Bounty queries the bounty attached to a work unit
+	Bounty(ctx context.Context, in *QueryBountyRequest, opts ...grpc.CallOption) (*QueryBountyResponse, error)
+	// ValidatorBond queries a validator's bond and unbonding stake
+	ValidatorBond(ctx context.Context, in *QueryValidatorBondRequest, opts ...grpc.CallOption) (*QueryValidatorBondResponse, error)
 	// ValidatorStats queries statistics for a validator
 	ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error)
 	// TotalStats queries total statistics
 	TotalStats(ctx context.Context, in *QueryTotalStatsRequest, opts ...grpc.CallOption) (*QueryTotalStatsResponse, error)
+	// CheckInvariants runs the module invariants against the current state. It
+	// scans the whole module store, so nodes serve it only when started with
+	// --workqueue.enable-invariants-query.
+	CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -69,6 +104,106 @@ func (c *queryClient) PendingWork(ctx context.Context, in *QueryPendingWorkReque
 	return out, nil
 }
 
+func (c *queryClient) ListWork(ctx context.Context, in *QueryListWorkRequest, opts ...grpc.CallOption) (*QueryListWorkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListWorkResponse)
+	err := c.cc.Invoke(ctx, Query_ListWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorkBySubmitter(ctx context.Context, in *QueryWorkBySubmitterRequest, opts ...grpc.CallOption) (*QueryWorkBySubmitterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWorkBySubmitterResponse)
+	err := c.cc.Invoke(ctx, Query_WorkBySubmitter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorkVotes(ctx context.Context, in *QueryWorkVotesRequest, opts ...grpc.CallOption) (*QueryWorkVotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWorkVotesResponse)
+	err := c.cc.Invoke(ctx, Query_WorkVotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidationCommits(ctx context.Context, in *QueryValidationCommitsRequest, opts ...grpc.CallOption) (*QueryValidationCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidationCommitsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidationCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Challenge(ctx context.Context, in *QueryChallengeRequest, opts ...grpc.CallOption) (*QueryChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryChallengeResponse)
+	err := c.cc.Invoke(ctx, Query_Challenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorkType(ctx context.Context, in *QueryWorkTypeRequest, opts ...grpc.CallOption) (*QueryWorkTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWorkTypeResponse)
+	err := c.cc.Invoke(ctx, Query_WorkType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorkTypes(ctx context.Context, in *QueryWorkTypesRequest, opts ...grpc.CallOption) (*QueryWorkTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWorkTypesResponse)
+	err := c.cc.Invoke(ctx, Query_WorkTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bounty(ctx context.Context, in *QueryBountyRequest, opts ...grpc.CallOption) (*QueryBountyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBountyResponse)
+	err := c.cc.Invoke(ctx, Query_Bounty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBond(ctx context.Context, in *QueryValidatorBondRequest, opts ...grpc.CallOption) (*QueryValidatorBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorBondResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryValidatorStatsResponse)
@@ -89,6 +224,16 @@ func (c *queryClient) TotalStats(ctx context.Context, in *QueryTotalStatsRequest
 	return out, nil
 }
 
+func (c *queryClient) CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCheckInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_CheckInvariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -99,10 +244,34 @@ type QueryServer interface {
 	Work(context.Context, *QueryWorkRequest) (*QueryWorkResponse, error)
 	// PendingWork queries for pending work units
 	PendingWork(context.Context, *QueryPendingWorkRequest) (*QueryPendingWorkResponse, error)
+	// ListWork queries work units in any status matching a filter
+	ListWork(context.Context, *QueryListWorkRequest) (*QueryListWorkResponse, error)
+	// WorkBySubmitter queries work units submitted by an address
+	WorkBySubmitter(context.Context, *QueryWorkBySubmitterRequest) (*QueryWorkBySubmitterResponse, error)
+	// WorkVotes queries the votes cast on a work unit and their tally
+	WorkVotes(context.Context, *QueryWorkVotesRequest) (*QueryWorkVotesResponse, error)
+	// ValidationCommits queries the unrevealed commitments on a work unit
+	ValidationCommits(context.Context, *QueryValidationCommitsRequest) (*QueryValidationCommitsResponse, error)
+	// Challenge queries the challenge raised against a work unit
+	Challenge(context.Context, *QueryChallengeRequest) (*QueryChallengeResponse, error)
+	// Params queries the module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// WorkType queries a registered work type definition
+	WorkType(context.Context, *QueryWorkTypeRequest) (*QueryWorkTypeResponse, error)
+	// WorkTypes lists the registered work type definitions
+	WorkTypes(context.Context, *QueryWorkTypesRequest) (*QueryWorkTypesResponse, error)
+	// Bounty queries the bounty attached to a work unit
+	Bounty(context.Context, *QueryBountyRequest) (*QueryBountyResponse, error)
+	// ValidatorBond queries a validator's bond and unbonding stake
+	ValidatorBond(context.Context, *QueryValidatorBondRequest) (*QueryValidatorBondResponse, error)
 	// ValidatorStats queries statistics for a validator
 	ValidatorStats(context.Context, *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error)
 	// TotalStats queries total statistics
 	TotalStats(context.Context, *QueryTotalStatsRequest) (*QueryTotalStatsResponse, error)
+	// CheckInvariants runs the module invariants against the current state. It
+	// scans the whole module store, so nodes serve it only when started with
+	// --workqueue.enable-invariants-query.
+	CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -119,12 +288,45 @@ func (UnimplementedQueryServer) Work(context.Context, *QueryWorkRequest) (*Query
 func (UnimplementedQueryServer) PendingWork(context.Context, *QueryPendingWorkRequest) (*QueryPendingWorkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PendingWork not implemented")
 }
+func (UnimplementedQueryServer) ListWork(context.Context, *QueryListWorkRequest) (*QueryListWorkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWork not implemented")
+}
+func (UnimplementedQueryServer) WorkBySubmitter(context.Context, *QueryWorkBySubmitterRequest) (*QueryWorkBySubmitterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkBySubmitter not implemented")
+}
+func (UnimplementedQueryServer) WorkVotes(context.Context, *QueryWorkVotesRequest) (*QueryWorkVotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkVotes not implemented")
+}
+func (UnimplementedQueryServer) ValidationCommits(context.Context, *QueryValidationCommitsRequest) (*QueryValidationCommitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidationCommits not implemented")
+}
+func (UnimplementedQueryServer) Challenge(context.Context, *QueryChallengeRequest) (*QueryChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Challenge not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) WorkType(context.Context, *QueryWorkTypeRequest) (*QueryWorkTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkType not implemented")
+}
+func (UnimplementedQueryServer) WorkTypes(context.Context, *QueryWorkTypesRequest) (*QueryWorkTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkTypes not implemented")
+}
+func (UnimplementedQueryServer) Bounty(context.Context, *QueryBountyRequest) (*QueryBountyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Bounty not implemented")
+}
+func (UnimplementedQueryServer) ValidatorBond(context.Context, *QueryValidatorBondRequest) (*QueryValidatorBondResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidatorBond not implemented")
+}
 func (UnimplementedQueryServer) ValidatorStats(context.Context, *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidatorStats not implemented")
 }
 func (UnimplementedQueryServer) TotalStats(context.Context, *QueryTotalStatsRequest) (*QueryTotalStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TotalStats not implemented")
 }
+func (UnimplementedQueryServer) CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckInvariants not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +384,186 @@ func _Query_PendingWork_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListWork(ctx, req.(*QueryListWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorkBySubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkBySubmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorkBySubmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WorkBySubmitter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorkBySubmitter(ctx, req.(*QueryWorkBySubmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorkVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorkVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WorkVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorkVotes(ctx, req.(*QueryWorkVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidationCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidationCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidationCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidationCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidationCommits(ctx, req.(*QueryValidationCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Challenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Challenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Challenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Challenge(ctx, req.(*QueryChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorkType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorkType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WorkType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorkType(ctx, req.(*QueryWorkTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorkTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorkTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WorkTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorkTypes(ctx, req.(*QueryWorkTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bounty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBountyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bounty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Bounty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bounty(ctx, req.(*QueryBountyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBond(ctx, req.(*QueryValidatorBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorStatsRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +600,24 @@ func _Query_TotalStats_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CheckInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckInvariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckInvariants(ctx, req.(*QueryCheckInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +633,46 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PendingWork",
 			Handler:    _Query_PendingWork_Handler,
 		},
+		{
+			MethodName: "ListWork",
+			Handler:    _Query_ListWork_Handler,
+		},
+		{
+			MethodName: "WorkBySubmitter",
+			Handler:    _Query_WorkBySubmitter_Handler,
+		},
+		{
+			MethodName: "WorkVotes",
+			Handler:    _Query_WorkVotes_Handler,
+		},
+		{
+			MethodName: "ValidationCommits",
+			Handler:    _Query_ValidationCommits_Handler,
+		},
+		{
+			MethodName: "Challenge",
+			Handler:    _Query_Challenge_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "WorkType",
+			Handler:    _Query_WorkType_Handler,
+		},
+		{
+			MethodName: "WorkTypes",
+			Handler:    _Query_WorkTypes_Handler,
+		},
+		{
+			MethodName: "Bounty",
+			Handler:    _Query_Bounty_Handler,
+		},
+		{
+			MethodName: "ValidatorBond",
+			Handler:    _Query_ValidatorBond_Handler,
+		},
 		{
 			MethodName: "ValidatorStats",
 			Handler:    _Query_ValidatorStats_Handler,
@@ -241,6 +681,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalStats",
 			Handler:    _Query_TotalStats_Handler,
 		},
+		{
+			MethodName: "CheckInvariants",
+			Handler:    _Query_CheckInvariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workqueue/v1/query.proto",
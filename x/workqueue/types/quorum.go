@@ -0,0 +1,67 @@
+package types
+
+import "fmt"
+
+// DefaultQuorumRule returns the quorum rule used for work types without an
+// explicit rule: a single vote decides the work.
+func DefaultQuorumRule(workType string) *QuorumRule {
+	return &QuorumRule{
+		WorkType:          workType,
+		RequiredVotes:     1,
+		RequiredAgreement: 1,
+	}
+}
+
+// Validate checks that a quorum rule can be satisfied
+func (r *QuorumRule) Validate() error {
+	if r.WorkType == "" {
+		return fmt.Errorf("quorum rule work type cannot be empty")
+	}
+	if r.RequiredVotes == 0 {
+		return fmt.Errorf("quorum rule for %s must require at least one vote", r.WorkType)
+	}
+	if r.RequiredAgreement == 0 || r.RequiredAgreement > r.RequiredVotes {
+		return fmt.Errorf("quorum rule for %s must require between 1 and %d agreeing votes", r.WorkType, r.RequiredVotes)
+	}
+	if r.MinAverageConfidence > 100 {
+		return fmt.Errorf("quorum rule for %s minimum confidence cannot exceed 100", r.WorkType)
+	}
+	return nil
+}
+
+// NewVoteTally tallies the votes cast on a work unit against its quorum rule
+func NewVoteTally(votes []*WorkVote, rule *QuorumRule) *VoteTally {
+	tally := &VoteTally{Rule: rule}
+
+	var validConfidence, invalidConfidence uint64
+	for _, vote := range votes {
+		if vote.Valid {
+			tally.ValidVotes++
+			validConfidence += uint64(vote.Confidence)
+		} else {
+			tally.InvalidVotes++
+			invalidConfidence += uint64(vote.Confidence)
+		}
+	}
+
+	if tally.ValidVotes > 0 {
+		tally.AverageValidConfidence = uint32(validConfidence / uint64(tally.ValidVotes))
+	}
+	if tally.InvalidVotes > 0 {
+		tally.AverageInvalidConfidence = uint32(invalidConfidence / uint64(tally.InvalidVotes))
+	}
+
+	return tally
+}
+
+// Reached reports whether enough votes have been cast to finalize the work
+func (t *VoteTally) Reached() bool {
+	return t.ValidVotes+t.InvalidVotes >= t.Rule.RequiredVotes
+}
+
+// Accepted reports whether the votes finalize the work as validated: at least
+// RequiredAgreement valid votes with sufficient average confidence.
+func (t *VoteTally) Accepted() bool {
+	return t.ValidVotes >= t.Rule.RequiredAgreement &&
+		t.AverageValidConfidence >= t.Rule.MinAverageConfidence
+}
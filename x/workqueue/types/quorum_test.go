@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+// votes returns valid and invalid votes with the given confidences
+func votes(valid, invalid []uint32) []*types.WorkVote {
+	var votes []*types.WorkVote
+	for _, confidence := range valid {
+		votes = append(votes, &types.WorkVote{Valid: true, Confidence: confidence})
+	}
+	for _, confidence := range invalid {
+		votes = append(votes, &types.WorkVote{Valid: false, Confidence: confidence})
+	}
+	return votes
+}
+
+func TestNewVoteTally(t *testing.T) {
+	rule := &types.QuorumRule{WorkType: "crypto", RequiredVotes: 3, RequiredAgreement: 2}
+
+	tests := []struct {
+		name                         string
+		votes                        []*types.WorkVote
+		valid, invalid               uint32
+		validAverage, invalidAverage uint32
+	}{
+		{"no votes", nil, 0, 0, 0, 0},
+		{"valid votes", votes([]uint32{90, 80}, nil), 2, 0, 85, 0},
+		{"invalid votes", votes(nil, []uint32{70}), 0, 1, 0, 70},
+		{"mixed votes", votes([]uint32{100}, []uint32{40, 60}), 1, 2, 100, 50},
+		{"averages round down", votes([]uint32{90, 91}, nil), 2, 0, 90, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tally := types.NewVoteTally(tc.votes, rule)
+			if tally.ValidVotes != tc.valid || tally.InvalidVotes != tc.invalid {
+				t.Fatalf("tallied %d valid and %d invalid votes, want %d and %d", tally.ValidVotes, tally.InvalidVotes, tc.valid, tc.invalid)
+			}
+			if tally.AverageValidConfidence != tc.validAverage || tally.AverageInvalidConfidence != tc.invalidAverage {
+				t.Fatalf("averaged %d valid and %d invalid confidence, want %d and %d",
+					tally.AverageValidConfidence, tally.AverageInvalidConfidence, tc.validAverage, tc.invalidAverage)
+			}
+			if tally.Rule != rule {
+				t.Fatalf("tally rule is %v, want %v", tally.Rule, rule)
+			}
+		})
+	}
+}
+
+func TestVoteTallyQuorum(t *testing.T) {
+	twoOfThree := &types.QuorumRule{WorkType: "crypto", RequiredVotes: 3, RequiredAgreement: 2}
+	confident := &types.QuorumRule{WorkType: "crypto", RequiredVotes: 2, RequiredAgreement: 1, MinAverageConfidence: 80}
+	evenSplit := &types.QuorumRule{WorkType: "crypto", RequiredVotes: 2, RequiredAgreement: 1}
+	unreachable := &types.QuorumRule{WorkType: "crypto", RequiredVotes: 2, RequiredAgreement: 3}
+
+	tests := []struct {
+		name     string
+		rule     *types.QuorumRule
+		votes    []*types.WorkVote
+		reached  bool
+		accepted bool
+	}{
+		{"default rule without votes", types.DefaultQuorumRule("crypto"), nil, false, false},
+		{"default rule valid vote", types.DefaultQuorumRule("crypto"), votes([]uint32{0}, nil), true, true},
+		{"default rule invalid vote", types.DefaultQuorumRule("crypto"), votes(nil, []uint32{100}), true, false},
+		{"short of quorum", twoOfThree, votes([]uint32{90, 90}, nil), false, true},
+		{"exact quorum with agreement", twoOfThree, votes([]uint32{90, 90}, []uint32{90}), true, true},
+		{"exact quorum short of agreement", twoOfThree, votes([]uint32{90}, []uint32{90, 90}), true, false},
+		{"past quorum", twoOfThree, votes([]uint32{90, 90}, []uint32{90, 90}), true, true},
+		{"tie accepted at agreement", evenSplit, votes([]uint32{90}, []uint32{90}), true, true},
+		{"exact minimum confidence", confident, votes([]uint32{70, 90}, nil), true, true},
+		{"below minimum confidence", confident, votes([]uint32{70, 89}, nil), true, false},
+		{"agreement beyond required votes", unreachable, votes([]uint32{90, 90}, nil), true, false},
+		{"agreement beyond required votes met late", unreachable, votes([]uint32{90, 90, 90}, nil), true, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tally := types.NewVoteTally(tc.votes, tc.rule)
+			if reached := tally.Reached(); reached != tc.reached {
+				t.Fatalf("quorum reached is %t, want %t", reached, tc.reached)
+			}
+			if accepted := tally.Accepted(); accepted != tc.accepted {
+				t.Fatalf("accepted is %t, want %t", accepted, tc.accepted)
+			}
+		})
+	}
+}
+
+func TestQuorumRuleValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  *types.QuorumRule
+		valid bool
+	}{
+		{"default rule", types.DefaultQuorumRule("crypto"), true},
+		{"unanimous", &types.QuorumRule{WorkType: "crypto", RequiredVotes: 3, RequiredAgreement: 3, MinAverageConfidence: 100}, true},
+		{"no work type", &types.QuorumRule{RequiredVotes: 1, RequiredAgreement: 1}, false},
+		{"no votes", &types.QuorumRule{WorkType: "crypto", RequiredAgreement: 1}, false},
+		{"no agreement", &types.QuorumRule{WorkType: "crypto", RequiredVotes: 1}, false},
+		{"agreement beyond required votes", &types.QuorumRule{WorkType: "crypto", RequiredVotes: 2, RequiredAgreement: 3}, false},
+		{"confidence above 100", &types.QuorumRule{WorkType: "crypto", RequiredVotes: 1, RequiredAgreement: 1, MinAverageConfidence: 101}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.rule.Validate(); (err == nil) != tc.valid {
+				t.Fatalf("validation returned %v, want valid %t", err, tc.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Schema is a parsed JSON schema used to validate submitted work data. Only
+// the deterministic subset of JSON Schema below is supported; any other
+// keyword is rejected so that a definition never silently skips a constraint:
+//
+//   - type: a type name or list of names (object, array, string, number,
+//     integer, boolean, null)
+//   - properties, required, additionalProperties (boolean or schema)
+//   - items, minItems, maxItems
+//   - minLength, maxLength (counted in characters)
+//   - minimum, maximum
+//   - enum
+//
+// The annotation keywords $schema, $id, title, description, default and
+// examples are accepted and ignored.
+//
+// Numbers are compared exactly, so numeric literals longer than
+// MaxSchemaNumberLength or with a decimal exponent beyond
+// MaxSchemaNumberExponent are rejected, in schemas and in compared data,
+// before they are expanded.
+type Schema struct {
+	types                []string
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *Schema
+	noAdditional         bool
+	items                *Schema
+	enum                 [][]byte
+	minLength, maxLength *uint64
+	minItems, maxItems   *uint64
+	minimum, maximum     *big.Rat
+}
+
+const (
+	// MaxSchemaNumberLength is the longest numeric literal compared exactly
+	MaxSchemaNumberLength = 100
+
+	// MaxSchemaNumberExponent is the largest decimal exponent, in absolute
+	// value, of a numeric literal compared exactly
+	MaxSchemaNumberExponent = 100
+)
+
+var schemaTypes = map[string]bool{
+	"object":  true,
+	"array":   true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"null":    true,
+}
+
+var schemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+}
+
+// ParseSchema parses a JSON schema
+func ParseSchema(schema string) (*Schema, error) {
+	return parseSchema([]byte(schema), "$")
+}
+
+func parseSchema(raw []byte, path string) (*Schema, error) {
+	var keywords map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &keywords); err != nil {
+		return nil, fmt.Errorf("%s: schema must be a JSON object: %w", path, err)
+	}
+
+	// Walk keywords in a fixed order so the first reported error is stable
+	names := make([]string, 0, len(keywords))
+	for name := range keywords {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s := &Schema{}
+	for _, name := range names {
+		value := keywords[name]
+		var err error
+		switch name {
+		case "type":
+			s.types, err = parseSchemaTypes(value)
+		case "properties":
+			var props map[string]json.RawMessage
+			if err = json.Unmarshal(value, &props); err != nil {
+				break
+			}
+			s.properties = make(map[string]*Schema, len(props))
+			for prop, propRaw := range props {
+				if s.properties[prop], err = parseSchema(propRaw, path+"."+prop); err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			err = json.Unmarshal(value, &s.required)
+		case "additionalProperties":
+			var allowed bool
+			if json.Unmarshal(value, &allowed) == nil {
+				s.noAdditional = !allowed
+				break
+			}
+			s.additionalProperties, err = parseSchema(value, path+".additionalProperties")
+		case "items":
+			s.items, err = parseSchema(value, path+"[]")
+		case "enum":
+			var values []json.RawMessage
+			if err = json.Unmarshal(value, &values); err != nil {
+				break
+			}
+			for _, v := range values {
+				canonical, cerr := canonicalJSON(v)
+				if cerr != nil {
+					return nil, fmt.Errorf("%s: invalid enum value: %w", path, cerr)
+				}
+				s.enum = append(s.enum, canonical)
+			}
+		case "minLength":
+			s.minLength, err = parseSchemaCount(value)
+		case "maxLength":
+			s.maxLength, err = parseSchemaCount(value)
+		case "minItems":
+			s.minItems, err = parseSchemaCount(value)
+		case "maxItems":
+			s.maxItems, err = parseSchemaCount(value)
+		case "minimum":
+			s.minimum, err = parseSchemaNumber(value)
+		case "maximum":
+			s.maximum, err = parseSchemaNumber(value)
+		default:
+			if !schemaAnnotations[name] {
+				return nil, fmt.Errorf("%s: unsupported schema keyword %q", path, name)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid %s: %w", path, name, err)
+		}
+	}
+
+	return s, nil
+}
+
+func parseSchemaTypes(raw json.RawMessage) ([]string, error) {
+	var types []string
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		types = []string{single}
+	} else if err := json.Unmarshal(raw, &types); err != nil {
+		return nil, err
+	}
+
+	for _, t := range types {
+		if !schemaTypes[t] {
+			return nil, fmt.Errorf("unknown type %q", t)
+		}
+	}
+	return types, nil
+}
+
+func parseSchemaCount(raw json.RawMessage) (*uint64, error) {
+	var count uint64
+	if err := json.Unmarshal(raw, &count); err != nil {
+		return nil, err
+	}
+	return &count, nil
+}
+
+func parseSchemaNumber(raw json.RawMessage) (*big.Rat, error) {
+	// Decoding into a json.Number would also accept a quoted number
+	value, err := decodeJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("expected a number")
+	}
+	return parseNumber(number)
+}
+
+// parseNumber converts a JSON number to an exact rational. Its length and
+// exponent are bounded first, as expanding a literal such as 1e1000000000
+// would take unbounded time and memory.
+func parseNumber(number json.Number) (*big.Rat, error) {
+	literal := number.String()
+	if len(literal) > MaxSchemaNumberLength {
+		return nil, fmt.Errorf("number %.20s... exceeds %d characters", literal, MaxSchemaNumberLength)
+	}
+	if i := strings.IndexAny(literal, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(literal[i+1:])
+		if err != nil || exponent > MaxSchemaNumberExponent || exponent < -MaxSchemaNumberExponent {
+			return nil, fmt.Errorf("number %s has an exponent beyond %d", literal, MaxSchemaNumberExponent)
+		}
+	}
+
+	rat, ok := new(big.Rat).SetString(literal)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", literal)
+	}
+	return rat, nil
+}
+
+// canonicalJSON re-encodes a JSON value with sorted object keys and no
+// insignificant whitespace so equal values compare equal byte for byte
+func canonicalJSON(raw []byte) ([]byte, error) {
+	value, err := decodeJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers exact
+func decodeJSON(raw []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// Validate checks that data is a JSON document satisfying the schema
+func (s *Schema) Validate(data []byte) error {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return fmt.Errorf("data is not valid JSON: %w", err)
+	}
+	return s.validate(value, "$")
+}
+
+func (s *Schema) validate(value any, path string) error {
+	if len(s.types) > 0 && !s.matchesType(value) {
+		return fmt.Errorf("%s: expected %v", path, s.types)
+	}
+
+	if len(s.enum) > 0 {
+		canonical, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		found := false
+		for _, allowed := range s.enum {
+			if bytes.Equal(canonical, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, prop := range s.required {
+			if _, ok := v[prop]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, prop)
+			}
+		}
+
+		props := make([]string, 0, len(v))
+		for prop := range v {
+			props = append(props, prop)
+		}
+		sort.Strings(props)
+
+		for _, prop := range props {
+			propSchema, ok := s.properties[prop]
+			switch {
+			case ok:
+			case s.noAdditional:
+				return fmt.Errorf("%s: unexpected property %q", path, prop)
+			case s.additionalProperties != nil:
+				propSchema = s.additionalProperties
+			default:
+				continue
+			}
+			if err := propSchema.validate(v[prop], path+"."+prop); err != nil {
+				return err
+			}
+		}
+
+	case []any:
+		count := uint64(len(v))
+		if s.minItems != nil && count < *s.minItems {
+			return fmt.Errorf("%s: expected at least %d items", path, *s.minItems)
+		}
+		if s.maxItems != nil && count > *s.maxItems {
+			return fmt.Errorf("%s: expected at most %d items", path, *s.maxItems)
+		}
+		if s.items != nil {
+			for i, item := range v {
+				if err := s.items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+		if s.minLength != nil && length < *s.minLength {
+			return fmt.Errorf("%s: expected at least %d characters", path, *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			return fmt.Errorf("%s: expected at most %d characters", path, *s.maxLength)
+		}
+
+	case json.Number:
+		if s.minimum == nil && s.maximum == nil {
+			break
+		}
+		rat, err := parseNumber(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if s.minimum != nil && rat.Cmp(s.minimum) < 0 {
+			return fmt.Errorf("%s: expected at least %s", path, s.minimum.RatString())
+		}
+		if s.maximum != nil && rat.Cmp(s.maximum) > 0 {
+			return fmt.Errorf("%s: expected at most %s", path, s.maximum.RatString())
+		}
+	}
+
+	return nil
+}
+
+func (s *Schema) matchesType(value any) bool {
+	for _, t := range s.types {
+		switch v := value.(type) {
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			}
+			if t == "integer" {
+				if rat, err := parseNumber(v); err == nil && rat.IsInt() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
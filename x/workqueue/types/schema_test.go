@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maco144/pickle/x/workqueue/types"
+)
+
+func TestParseSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{"empty schema", `{}`, ""},
+		{"annotations", `{"$schema":"x","$id":"x","title":"t","description":"d","default":1,"examples":[1]}`, ""},
+		{"type name", `{"type":"object"}`, ""},
+		{"type list", `{"type":["string","null"]}`, ""},
+		{"unknown type", `{"type":"float"}`, `unknown type "float"`},
+		{"type not a name", `{"type":1}`, "invalid type"},
+		{"properties", `{"properties":{"a":{"type":"string"}}}`, ""},
+		{"invalid property schema", `{"properties":{"a":{"type":"float"}}}`, `$.a: invalid type`},
+		{"required", `{"required":["a","b"]}`, ""},
+		{"required not a list", `{"required":"a"}`, "invalid required"},
+		{"additionalProperties boolean", `{"additionalProperties":false}`, ""},
+		{"additionalProperties schema", `{"additionalProperties":{"type":"integer"}}`, ""},
+		{"invalid additionalProperties", `{"additionalProperties":1}`, "$.additionalProperties: schema must be a JSON object"},
+		{"items", `{"items":{"type":"number"}}`, ""},
+		{"invalid items", `{"items":[]}`, "$[]: schema must be a JSON object"},
+		{"item counts", `{"minItems":0,"maxItems":3}`, ""},
+		{"negative item count", `{"minItems":-1}`, "invalid minItems"},
+		{"fractional item count", `{"maxItems":1.5}`, "invalid maxItems"},
+		{"lengths", `{"minLength":1,"maxLength":10}`, ""},
+		{"negative length", `{"maxLength":-1}`, "invalid maxLength"},
+		{"bounds", `{"minimum":-1.5,"maximum":1e3}`, ""},
+		{"bound not a number", `{"minimum":"1"}`, "invalid minimum"},
+		{"longest bound", `{"maximum":` + strings.Repeat("9", types.MaxSchemaNumberLength) + `}`, ""},
+		{"bound too long", `{"maximum":` + strings.Repeat("9", types.MaxSchemaNumberLength+1) + `}`, "exceeds 100 characters"},
+		{"largest exponent", `{"maximum":1e100}`, ""},
+		{"smallest exponent", `{"minimum":1E-100}`, ""},
+		{"exponent too large", `{"maximum":1e101}`, "exponent beyond 100"},
+		{"exponent too small", `{"minimum":1e-101}`, "exponent beyond 100"},
+		{"huge exponent", `{"maximum":1e1000000000}`, "exponent beyond 100"},
+		{"enum", `{"enum":[1,"a",{"b":[true,null]}]}`, ""},
+		{"enum not a list", `{"enum":1}`, "invalid enum"},
+		{"unsupported keyword", `{"pattern":"^a"}`, `unsupported schema keyword "pattern"`},
+		{"not an object", `[]`, "schema must be a JSON object"},
+		{"not JSON", `{`, "schema must be a JSON object"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseSchema(tc.schema)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("parsing %s failed: %v", tc.schema, err)
+			case tc.wantErr != "" && err == nil:
+				t.Fatalf("parsing %s succeeded, want an error containing %q", tc.schema, tc.wantErr)
+			case tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr):
+				t.Fatalf("parsing %s returned %q, want an error containing %q", tc.schema, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		data    string
+		wantErr string
+	}{
+		{"any value", `{}`, `[1,"a"]`, ""},
+		{"invalid JSON", `{}`, `{"a":`, "data is not valid JSON"},
+		{"trailing data", `{}`, `{} {}`, "data is not valid JSON"},
+
+		{"object type", `{"type":"object"}`, `{}`, ""},
+		{"array type", `{"type":"array"}`, `[]`, ""},
+		{"string type", `{"type":"string"}`, `"a"`, ""},
+		{"boolean type", `{"type":"boolean"}`, `false`, ""},
+		{"null type", `{"type":"null"}`, `null`, ""},
+		{"number type", `{"type":"number"}`, `1.5`, ""},
+		{"integer type", `{"type":"integer"}`, `3`, ""},
+		{"integral decimal is an integer", `{"type":"integer"}`, `3.0`, ""},
+		{"fraction is not an integer", `{"type":"integer"}`, `3.5`, "$: expected [integer]"},
+		{"huge exponent is not an integer", `{"type":"integer"}`, `1e1000000000`, "$: expected [integer]"},
+		{"type list", `{"type":["string","null"]}`, `null`, ""},
+		{"type mismatch", `{"type":"string"}`, `1`, "$: expected [string]"},
+
+		{"required present", `{"required":["a"]}`, `{"a":1}`, ""},
+		{"required missing", `{"required":["a"]}`, `{"b":1}`, `$: missing required property "a"`},
+		{"property schema", `{"properties":{"a":{"type":"string"}}}`, `{"a":1}`, "$.a: expected [string]"},
+		{"additional allowed", `{"properties":{"a":{}}}`, `{"a":1,"b":2}`, ""},
+		{"additional forbidden", `{"properties":{"a":{}},"additionalProperties":false}`, `{"a":1,"b":2}`, `$: unexpected property "b"`},
+		{"additional schema", `{"additionalProperties":{"type":"integer"}}`, `{"b":"x"}`, "$.b: expected [integer]"},
+
+		{"items schema", `{"items":{"type":"string"}}`, `["a",1]`, "$[1]: expected [string]"},
+		{"minItems boundary", `{"minItems":2}`, `[1,2]`, ""},
+		{"below minItems", `{"minItems":2}`, `[1]`, "expected at least 2 items"},
+		{"maxItems boundary", `{"maxItems":2}`, `[1,2]`, ""},
+		{"above maxItems", `{"maxItems":2}`, `[1,2,3]`, "expected at most 2 items"},
+
+		{"minLength counts characters", `{"minLength":2}`, `"éé"`, ""},
+		{"below minLength", `{"minLength":2}`, `"é"`, "expected at least 2 characters"},
+		{"maxLength counts characters", `{"maxLength":2}`, `"éé"`, ""},
+		{"above maxLength", `{"maxLength":2}`, `"abc"`, "expected at most 2 characters"},
+
+		{"minimum boundary", `{"minimum":1.5}`, `1.50`, ""},
+		{"below minimum", `{"minimum":1.5}`, `1.4999999999999999999999`, "expected at least 3/2"},
+		{"maximum boundary", `{"maximum":1e3}`, `1000`, ""},
+		{"above maximum", `{"maximum":1e3}`, `1000.0000000000000000001`, "expected at most 1000"},
+		{"compared number too long", `{"maximum":1}`, `0.` + strings.Repeat("0", types.MaxSchemaNumberLength), "exceeds 100 characters"},
+		{"compared exponent too large", `{"minimum":0}`, `1e1000000000`, "exponent beyond 100"},
+		{"uncompared numbers are not expanded", `{"type":"number"}`, `1e1000000000`, ""},
+
+		{"enum match", `{"enum":[{"a":1,"b":[true]}]}`, `{"b":[true],"a":1}`, ""},
+		{"enum mismatch", `{"enum":["a","b"]}`, `"c"`, "value is not one of the allowed values"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema, err := types.ParseSchema(tc.schema)
+			if err != nil {
+				t.Fatalf("parsing %s failed: %v", tc.schema, err)
+			}
+
+			err = schema.Validate([]byte(tc.data))
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("validating %s failed: %v", tc.data, err)
+			case tc.wantErr != "" && err == nil:
+				t.Fatalf("validating %s succeeded, want an error containing %q", tc.data, tc.wantErr)
+			case tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr):
+				t.Fatalf("validating %s returned %q, want an error containing %q", tc.data, err, tc.wantErr)
+			}
+		})
+	}
+}
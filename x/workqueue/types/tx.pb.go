@@ -7,6 +7,9 @@
 package types
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	_ "cosmossdk.io/api/cosmos/msg/v1"
+	_ "github.com/cosmos/cosmos-proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -30,8 +33,15 @@ type MsgSubmitWork struct {
 	WorkType string `protobuf:"bytes,2,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
 	// WorkData is the raw data to validate
 	WorkData []byte `protobuf:"bytes,3,opt,name=work_data,json=workData,proto3" json:"work_data,omitempty"`
-	// WorkID is a unique identifier (optional, generated if not provided)
-	WorkId        string `protobuf:"bytes,4,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// WorkID is a unique identifier (optional, the content hash of the work if
+	// not provided). IDs in the content hash format must be the hash of this
+	// work.
+	WorkId string `protobuf:"bytes,4,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Bounty is an optional payment escrowed until the work is finalized
+	Bounty *v1beta1.Coin `protobuf:"bytes,5,opt,name=bounty,proto3" json:"bounty,omitempty"`
+	// Priority is the scheduling priority of the work, up to the MaxPriority
+	// parameter. Higher priority work is served first.
+	Priority      uint32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -94,6 +104,20 @@ func (x *MsgSubmitWork) GetWorkId() string {
 	return ""
 }
 
+func (x *MsgSubmitWork) GetBounty() *v1beta1.Coin {
+	if x != nil {
+		return x.Bounty
+	}
+	return nil
+}
+
+func (x *MsgSubmitWork) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 // MsgSubmitWorkResponse is the response to SubmitWork
 type MsgSubmitWorkResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -140,6 +164,107 @@ func (x *MsgSubmitWorkResponse) GetWorkId() string {
 	return ""
 }
 
+// MsgClaimWork leases a pending work unit to a validator
+type MsgClaimWork struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the address of the validator claiming the work
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// WorkID is the ID of the work unit being claimed
+	WorkId        string `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgClaimWork) Reset() {
+	*x = MsgClaimWork{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgClaimWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimWork) ProtoMessage() {}
+
+func (x *MsgClaimWork) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgClaimWork.ProtoReflect.Descriptor instead.
+func (*MsgClaimWork) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgClaimWork) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MsgClaimWork) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+// MsgClaimWorkResponse is the response to ClaimWork
+type MsgClaimWorkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// LeaseExpiresAt is the last block height at which the claim is valid
+	LeaseExpiresAt int64 `protobuf:"varint,1,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MsgClaimWorkResponse) Reset() {
+	*x = MsgClaimWorkResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgClaimWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimWorkResponse) ProtoMessage() {}
+
+func (x *MsgClaimWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgClaimWorkResponse.ProtoReflect.Descriptor instead.
+func (*MsgClaimWorkResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgClaimWorkResponse) GetLeaseExpiresAt() int64 {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return 0
+}
+
 // MsgValidateWork submits a validation result for a work unit
 type MsgValidateWork struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -161,7 +286,7 @@ type MsgValidateWork struct {
 
 func (x *MsgValidateWork) Reset() {
 	*x = MsgValidateWork{}
-	mi := &file_workqueue_v1_tx_proto_msgTypes[2]
+	mi := &file_workqueue_v1_tx_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +298,7 @@ func (x *MsgValidateWork) String() string {
 func (*MsgValidateWork) ProtoMessage() {}
 
 func (x *MsgValidateWork) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_tx_proto_msgTypes[2]
+	mi := &file_workqueue_v1_tx_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +311,7 @@ func (x *MsgValidateWork) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgValidateWork.ProtoReflect.Descriptor instead.
 func (*MsgValidateWork) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{2}
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MsgValidateWork) GetValidator() string {
@@ -240,7 +365,7 @@ type MsgValidateWorkResponse struct {
 
 func (x *MsgValidateWorkResponse) Reset() {
 	*x = MsgValidateWorkResponse{}
-	mi := &file_workqueue_v1_tx_proto_msgTypes[3]
+	mi := &file_workqueue_v1_tx_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -252,7 +377,7 @@ func (x *MsgValidateWorkResponse) String() string {
 func (*MsgValidateWorkResponse) ProtoMessage() {}
 
 func (x *MsgValidateWorkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_tx_proto_msgTypes[3]
+	mi := &file_workqueue_v1_tx_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,37 +390,38 @@ func (x *MsgValidateWorkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgValidateWorkResponse.ProtoReflect.Descriptor instead.
 func (*MsgValidateWorkResponse) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{3}
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
-// MsgRejectWork explicitly rejects a work unit
-type MsgRejectWork struct {
+// MsgCommitValidation commits to a sealed verdict on a claimed work unit
+type MsgCommitValidation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Validator is the address of the validator
+	// Validator is the address of the validator holding the lease
 	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// WorkID is the ID of the work unit being rejected
+	// WorkID is the ID of the work unit
 	WorkId string `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
-	// Reason is the reason for rejection
-	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Commitment is the SHA-256 hash of the work ID, validator, verdict,
+	// confidence, proof and salt, see ValidationCommitment
+	Commitment    []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MsgRejectWork) Reset() {
-	*x = MsgRejectWork{}
-	mi := &file_workqueue_v1_tx_proto_msgTypes[4]
+func (x *MsgCommitValidation) Reset() {
+	*x = MsgCommitValidation{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MsgRejectWork) String() string {
+func (x *MsgCommitValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRejectWork) ProtoMessage() {}
+func (*MsgCommitValidation) ProtoMessage() {}
 
-func (x *MsgRejectWork) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_tx_proto_msgTypes[4]
+func (x *MsgCommitValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,54 +432,182 @@ func (x *MsgRejectWork) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgRejectWork.ProtoReflect.Descriptor instead.
-func (*MsgRejectWork) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgCommitValidation.ProtoReflect.Descriptor instead.
+func (*MsgCommitValidation) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MsgRejectWork) GetValidator() string {
+func (x *MsgCommitValidation) GetValidator() string {
 	if x != nil {
 		return x.Validator
 	}
 	return ""
 }
 
-func (x *MsgRejectWork) GetWorkId() string {
+func (x *MsgCommitValidation) GetWorkId() string {
 	if x != nil {
 		return x.WorkId
 	}
 	return ""
 }
 
-func (x *MsgRejectWork) GetReason() string {
+func (x *MsgCommitValidation) GetCommitment() []byte {
 	if x != nil {
-		return x.Reason
+		return x.Commitment
+	}
+	return nil
+}
+
+// MsgCommitValidationResponse is the response to CommitValidation
+type MsgCommitValidationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgCommitValidationResponse) Reset() {
+	*x = MsgCommitValidationResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgCommitValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCommitValidationResponse) ProtoMessage() {}
+
+func (x *MsgCommitValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgCommitValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgCommitValidationResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgRevealValidation reveals a committed verdict
+type MsgRevealValidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the address of the committing validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// WorkID is the ID of the work unit
+	WorkId string `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Valid indicates if the work unit passed validation
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Confidence is the validator's confidence in this result (0-100)
+	Confidence uint32 `protobuf:"varint,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// Proof is optional proof of validation, or the rejection reason
+	Proof string `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Salt is the secret random value the commitment was made with
+	Salt          []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgRevealValidation) Reset() {
+	*x = MsgRevealValidation{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgRevealValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealValidation) ProtoMessage() {}
+
+func (x *MsgRevealValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgRevealValidation.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRevealValidation) GetValidator() string {
+	if x != nil {
+		return x.Validator
 	}
 	return ""
 }
 
-// MsgRejectWorkResponse is the response to RejectWork
-type MsgRejectWorkResponse struct {
+func (x *MsgRevealValidation) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *MsgRevealValidation) GetConfidence() uint32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *MsgRevealValidation) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+// MsgRevealValidationResponse is the response to RevealValidation
+type MsgRevealValidationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MsgRejectWorkResponse) Reset() {
-	*x = MsgRejectWorkResponse{}
-	mi := &file_workqueue_v1_tx_proto_msgTypes[5]
+func (x *MsgRevealValidationResponse) Reset() {
+	*x = MsgRevealValidationResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MsgRejectWorkResponse) String() string {
+func (x *MsgRevealValidationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRejectWorkResponse) ProtoMessage() {}
+func (*MsgRevealValidationResponse) ProtoMessage() {}
 
-func (x *MsgRejectWorkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_tx_proto_msgTypes[5]
+func (x *MsgRevealValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,44 +618,694 @@ func (x *MsgRejectWorkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgRejectWorkResponse.ProtoReflect.Descriptor instead.
-func (*MsgRejectWorkResponse) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgRevealValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
-var File_workqueue_v1_tx_proto protoreflect.FileDescriptor
+// MsgRejectWork explicitly rejects a work unit
+type MsgRejectWork struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// WorkID is the ID of the work unit being rejected
+	WorkId string `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Reason is the reason for rejection
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_workqueue_v1_tx_proto_rawDesc = "" +
-	"\n" +
-	"\x15workqueue/v1/tx.proto\x12\x13pickle.workqueue.v1\"\x80\x01\n" +
-	"\rMsgSubmitWork\x12\x1c\n" +
-	"\tsubmitter\x18\x01 \x01(\tR\tsubmitter\x12\x1b\n" +
-	"\twork_type\x18\x02 \x01(\tR\bworkType\x12\x1b\n" +
-	"\twork_data\x18\x03 \x01(\fR\bworkData\x12\x17\n" +
-	"\awork_id\x18\x04 \x01(\tR\x06workId\"0\n" +
-	"\x15MsgSubmitWorkResponse\x12\x17\n" +
-	"\awork_id\x18\x01 \x01(\tR\x06workId\"\xac\x01\n" +
-	"\x0fMsgValidateWork\x12\x1c\n" +
-	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x12\x17\n" +
-	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x14\n" +
-	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1e\n" +
-	"\n" +
-	"confidence\x18\x04 \x01(\rR\n" +
-	"confidence\x12\x14\n" +
-	"\x05proof\x18\x05 \x01(\tR\x05proof\x12\x16\n" +
-	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x19\n" +
-	"\x17MsgValidateWorkResponse\"^\n" +
-	"\rMsgRejectWork\x12\x1c\n" +
-	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x12\x17\n" +
-	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x17\n" +
-	"\x15MsgRejectWorkResponse2\xa5\x02\n" +
+func (x *MsgRejectWork) Reset() {
+	*x = MsgRejectWork{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgRejectWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRejectWork) ProtoMessage() {}
+
+func (x *MsgRejectWork) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgRejectWork.ProtoReflect.Descriptor instead.
+func (*MsgRejectWork) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRejectWork) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MsgRejectWork) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *MsgRejectWork) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// MsgRejectWorkResponse is the response to RejectWork
+type MsgRejectWorkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgRejectWorkResponse) Reset() {
+	*x = MsgRejectWorkResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgRejectWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRejectWorkResponse) ProtoMessage() {}
+
+func (x *MsgRejectWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgRejectWorkResponse.ProtoReflect.Descriptor instead.
+func (*MsgRejectWorkResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgChallengeWork disputes the finalized outcome of a work unit
+type MsgChallengeWork struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Challenger is the address raising the challenge
+	Challenger string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// WorkID is the ID of the work unit being challenged
+	WorkId string `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Bond is the amount escrowed until the challenge is resolved
+	Bond *v1beta1.Coin `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	// Reason explains why the outcome is disputed
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgChallengeWork) Reset() {
+	*x = MsgChallengeWork{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgChallengeWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgChallengeWork) ProtoMessage() {}
+
+func (x *MsgChallengeWork) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgChallengeWork.ProtoReflect.Descriptor instead.
+func (*MsgChallengeWork) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgChallengeWork) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *MsgChallengeWork) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *MsgChallengeWork) GetBond() *v1beta1.Coin {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *MsgChallengeWork) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// MsgChallengeWorkResponse is the response to ChallengeWork
+type MsgChallengeWorkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgChallengeWorkResponse) Reset() {
+	*x = MsgChallengeWorkResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgChallengeWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgChallengeWorkResponse) ProtoMessage() {}
+
+func (x *MsgChallengeWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgChallengeWorkResponse.ProtoReflect.Descriptor instead.
+func (*MsgChallengeWorkResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgBond bonds stake to register as or remain a validator
+type MsgBond struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the address bonding stake
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Amount is the stake to bond
+	Amount        *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgBond) Reset() {
+	*x = MsgBond{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgBond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBond) ProtoMessage() {}
+
+func (x *MsgBond) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgBond.ProtoReflect.Descriptor instead.
+func (*MsgBond) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgBond) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MsgBond) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// MsgBondResponse is the response to Bond
+type MsgBondResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgBondResponse) Reset() {
+	*x = MsgBondResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgBondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBondResponse) ProtoMessage() {}
+
+func (x *MsgBondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgBondResponse.ProtoReflect.Descriptor instead.
+func (*MsgBondResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+// MsgUnbond starts unbonding stake from a validator's bond
+type MsgUnbond struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the address unbonding stake
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Amount is the stake to unbond
+	Amount        *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgUnbond) Reset() {
+	*x = MsgUnbond{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUnbond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnbond) ProtoMessage() {}
+
+func (x *MsgUnbond) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUnbond.ProtoReflect.Descriptor instead.
+func (*MsgUnbond) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgUnbond) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MsgUnbond) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// MsgUnbondResponse is the response to Unbond
+type MsgUnbondResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CompletionHeight is the block height at which the stake is returned
+	CompletionHeight int64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MsgUnbondResponse) Reset() {
+	*x = MsgUnbondResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUnbondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnbondResponse) ProtoMessage() {}
+
+func (x *MsgUnbondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUnbondResponse.ProtoReflect.Descriptor instead.
+func (*MsgUnbondResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MsgUnbondResponse) GetCompletionHeight() int64 {
+	if x != nil {
+		return x.CompletionHeight
+	}
+	return 0
+}
+
+// MsgUpdateParams updates the module parameters
+type MsgUpdateParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authority is the address allowed to update the parameters, by default the
+	// governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params is the full set of new parameters
+	Params        *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse is the response to UpdateParams
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+// MsgSetWorkTypeDefinition registers or replaces a work type definition
+type MsgSetWorkTypeDefinition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authority is the address allowed to manage work types, by default the
+	// governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Definition is the work type definition to store
+	Definition    *WorkTypeDefinition `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSetWorkTypeDefinition) Reset() {
+	*x = MsgSetWorkTypeDefinition{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSetWorkTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetWorkTypeDefinition) ProtoMessage() {}
+
+func (x *MsgSetWorkTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSetWorkTypeDefinition.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgSetWorkTypeDefinition) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgSetWorkTypeDefinition) GetDefinition() *WorkTypeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+// MsgSetWorkTypeDefinitionResponse is the response to SetWorkTypeDefinition
+type MsgSetWorkTypeDefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSetWorkTypeDefinitionResponse) Reset() {
+	*x = MsgSetWorkTypeDefinitionResponse{}
+	mi := &file_workqueue_v1_tx_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSetWorkTypeDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetWorkTypeDefinitionResponse) ProtoMessage() {}
+
+func (x *MsgSetWorkTypeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_tx_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSetWorkTypeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkTypeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+var File_workqueue_v1_tx_proto protoreflect.FileDescriptor
+
+const file_workqueue_v1_tx_proto_rawDesc = "" +
+	"\n" +
+	"\x15workqueue/v1/tx.proto\x12\x13pickle.workqueue.v1\x1a\x1ecosmos/base/v1beta1/coin.proto\x1a\x17cosmos/msg/v1/msg.proto\x1a\x19cosmos_proto/cosmos.proto\x1a\x1cworkqueue/v1/workqueue.proto\"\xf9\x01\n" +
+	"\rMsgSubmitWork\x126\n" +
+	"\tsubmitter\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tsubmitter\x12\x1b\n" +
+	"\twork_type\x18\x02 \x01(\tR\bworkType\x12\x1b\n" +
+	"\twork_data\x18\x03 \x01(\fR\bworkData\x12\x17\n" +
+	"\awork_id\x18\x04 \x01(\tR\x06workId\x121\n" +
+	"\x06bounty\x18\x05 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06bounty\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\rR\bpriority:\x0e\x82\xe7\xb0*\tsubmitter\"0\n" +
+	"\x15MsgSubmitWorkResponse\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\"o\n" +
+	"\fMsgClaimWork\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId:\x0e\x82\xe7\xb0*\tvalidator\"@\n" +
+	"\x14MsgClaimWorkResponse\x12(\n" +
+	"\x10lease_expires_at\x18\x01 \x01(\x03R\x0eleaseExpiresAt\"\xd6\x01\n" +
+	"\x0fMsgValidateWork\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\rR\n" +
+	"confidence\x12\x14\n" +
+	"\x05proof\x18\x05 \x01(\tR\x05proof\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason:\x0e\x82\xe7\xb0*\tvalidator\"\x19\n" +
+	"\x17MsgValidateWorkResponse\"\x96\x01\n" +
+	"\x13MsgCommitValidation\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\fR\n" +
+	"commitment:\x0e\x82\xe7\xb0*\tvalidator\"\x1d\n" +
+	"\x1bMsgCommitValidationResponse\"\xd6\x01\n" +
+	"\x13MsgRevealValidation\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\rR\n" +
+	"confidence\x12\x14\n" +
+	"\x05proof\x18\x05 \x01(\tR\x05proof\x12\x12\n" +
+	"\x04salt\x18\x06 \x01(\fR\x04salt:\x0e\x82\xe7\xb0*\tvalidator\"\x1d\n" +
+	"\x1bMsgRevealValidationResponse\"\x88\x01\n" +
+	"\rMsgRejectWork\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason:\x0e\x82\xe7\xb0*\tvalidator\"\x17\n" +
+	"\x15MsgRejectWorkResponse\"\xbd\x01\n" +
+	"\x10MsgChallengeWork\x128\n" +
+	"\n" +
+	"challenger\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\n" +
+	"challenger\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12-\n" +
+	"\x04bond\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x04bond\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason:\x0f\x82\xe7\xb0*\n" +
+	"challenger\"\x1a\n" +
+	"\x18MsgChallengeWorkResponse\"\x84\x01\n" +
+	"\aMsgBond\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount:\x0e\x82\xe7\xb0*\tvalidator\"\x11\n" +
+	"\x0fMsgBondResponse\"\x86\x01\n" +
+	"\tMsgUnbond\x126\n" +
+	"\tvalidator\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount:\x0e\x82\xe7\xb0*\tvalidator\"@\n" +
+	"\x11MsgUnbondResponse\x12+\n" +
+	"\x11completion_height\x18\x01 \x01(\x03R\x10completionHeight\"\x8e\x01\n" +
+	"\x0fMsgUpdateParams\x126\n" +
+	"\tauthority\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tauthority\x123\n" +
+	"\x06params\x18\x02 \x01(\v2\x1b.pickle.workqueue.v1.ParamsR\x06params:\x0e\x82\xe7\xb0*\tauthority\"\x19\n" +
+	"\x17MsgUpdateParamsResponse\"\xab\x01\n" +
+	"\x18MsgSetWorkTypeDefinition\x126\n" +
+	"\tauthority\x18\x01 \x01(\tB\x18Ҵ-\x14cosmos.AddressStringR\tauthority\x12G\n" +
+	"\n" +
+	"definition\x18\x02 \x01(\v2'.pickle.workqueue.v1.WorkTypeDefinitionR\n" +
+	"definition:\x0e\x82\xe7\xb0*\tauthority\"\"\n" +
+	" MsgSetWorkTypeDefinitionResponse2\xcf\b\n" +
 	"\x03Msg\x12\\\n" +
 	"\n" +
-	"SubmitWork\x12\".pickle.workqueue.v1.MsgSubmitWork\x1a*.pickle.workqueue.v1.MsgSubmitWorkResponse\x12b\n" +
-	"\fValidateWork\x12$.pickle.workqueue.v1.MsgValidateWork\x1a,.pickle.workqueue.v1.MsgValidateWorkResponse\x12\\\n" +
+	"SubmitWork\x12\".pickle.workqueue.v1.MsgSubmitWork\x1a*.pickle.workqueue.v1.MsgSubmitWorkResponse\x12Y\n" +
+	"\tClaimWork\x12!.pickle.workqueue.v1.MsgClaimWork\x1a).pickle.workqueue.v1.MsgClaimWorkResponse\x12b\n" +
+	"\fValidateWork\x12$.pickle.workqueue.v1.MsgValidateWork\x1a,.pickle.workqueue.v1.MsgValidateWorkResponse\x12n\n" +
+	"\x10CommitValidation\x12(.pickle.workqueue.v1.MsgCommitValidation\x1a0.pickle.workqueue.v1.MsgCommitValidationResponse\x12n\n" +
+	"\x10RevealValidation\x12(.pickle.workqueue.v1.MsgRevealValidation\x1a0.pickle.workqueue.v1.MsgRevealValidationResponse\x12\\\n" +
 	"\n" +
-	"RejectWork\x12\".pickle.workqueue.v1.MsgRejectWork\x1a*.pickle.workqueue.v1.MsgRejectWorkResponseB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
+	"RejectWork\x12\".pickle.workqueue.v1.MsgRejectWork\x1a*.pickle.workqueue.v1.MsgRejectWorkResponse\x12e\n" +
+	"\rChallengeWork\x12%.pickle.workqueue.v1.MsgChallengeWork\x1a-.pickle.workqueue.v1.MsgChallengeWorkResponse\x12J\n" +
+	"\x04Bond\x12\x1c.pickle.workqueue.v1.MsgBond\x1a$.pickle.workqueue.v1.MsgBondResponse\x12P\n" +
+	"\x06Unbond\x12\x1e.pickle.workqueue.v1.MsgUnbond\x1a&.pickle.workqueue.v1.MsgUnbondResponse\x12b\n" +
+	"\fUpdateParams\x12$.pickle.workqueue.v1.MsgUpdateParams\x1a,.pickle.workqueue.v1.MsgUpdateParamsResponse\x12}\n" +
+	"\x15SetWorkTypeDefinition\x12-.pickle.workqueue.v1.MsgSetWorkTypeDefinition\x1a5.pickle.workqueue.v1.MsgSetWorkTypeDefinitionResponse\x1a\x05\x80\xe7\xb0*\x01B-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
 
 var (
 	file_workqueue_v1_tx_proto_rawDescOnce sync.Once
@@ -415,27 +1319,68 @@ func file_workqueue_v1_tx_proto_rawDescGZIP() []byte {
 	return file_workqueue_v1_tx_proto_rawDescData
 }
 
-var file_workqueue_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_workqueue_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_workqueue_v1_tx_proto_goTypes = []any{
-	(*MsgSubmitWork)(nil),           // 0: pickle.workqueue.v1.MsgSubmitWork
-	(*MsgSubmitWorkResponse)(nil),   // 1: pickle.workqueue.v1.MsgSubmitWorkResponse
-	(*MsgValidateWork)(nil),         // 2: pickle.workqueue.v1.MsgValidateWork
-	(*MsgValidateWorkResponse)(nil), // 3: pickle.workqueue.v1.MsgValidateWorkResponse
-	(*MsgRejectWork)(nil),           // 4: pickle.workqueue.v1.MsgRejectWork
-	(*MsgRejectWorkResponse)(nil),   // 5: pickle.workqueue.v1.MsgRejectWorkResponse
+	(*MsgSubmitWork)(nil),                    // 0: pickle.workqueue.v1.MsgSubmitWork
+	(*MsgSubmitWorkResponse)(nil),            // 1: pickle.workqueue.v1.MsgSubmitWorkResponse
+	(*MsgClaimWork)(nil),                     // 2: pickle.workqueue.v1.MsgClaimWork
+	(*MsgClaimWorkResponse)(nil),             // 3: pickle.workqueue.v1.MsgClaimWorkResponse
+	(*MsgValidateWork)(nil),                  // 4: pickle.workqueue.v1.MsgValidateWork
+	(*MsgValidateWorkResponse)(nil),          // 5: pickle.workqueue.v1.MsgValidateWorkResponse
+	(*MsgCommitValidation)(nil),              // 6: pickle.workqueue.v1.MsgCommitValidation
+	(*MsgCommitValidationResponse)(nil),      // 7: pickle.workqueue.v1.MsgCommitValidationResponse
+	(*MsgRevealValidation)(nil),              // 8: pickle.workqueue.v1.MsgRevealValidation
+	(*MsgRevealValidationResponse)(nil),      // 9: pickle.workqueue.v1.MsgRevealValidationResponse
+	(*MsgRejectWork)(nil),                    // 10: pickle.workqueue.v1.MsgRejectWork
+	(*MsgRejectWorkResponse)(nil),            // 11: pickle.workqueue.v1.MsgRejectWorkResponse
+	(*MsgChallengeWork)(nil),                 // 12: pickle.workqueue.v1.MsgChallengeWork
+	(*MsgChallengeWorkResponse)(nil),         // 13: pickle.workqueue.v1.MsgChallengeWorkResponse
+	(*MsgBond)(nil),                          // 14: pickle.workqueue.v1.MsgBond
+	(*MsgBondResponse)(nil),                  // 15: pickle.workqueue.v1.MsgBondResponse
+	(*MsgUnbond)(nil),                        // 16: pickle.workqueue.v1.MsgUnbond
+	(*MsgUnbondResponse)(nil),                // 17: pickle.workqueue.v1.MsgUnbondResponse
+	(*MsgUpdateParams)(nil),                  // 18: pickle.workqueue.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),          // 19: pickle.workqueue.v1.MsgUpdateParamsResponse
+	(*MsgSetWorkTypeDefinition)(nil),         // 20: pickle.workqueue.v1.MsgSetWorkTypeDefinition
+	(*MsgSetWorkTypeDefinitionResponse)(nil), // 21: pickle.workqueue.v1.MsgSetWorkTypeDefinitionResponse
+	(*v1beta1.Coin)(nil),                     // 22: cosmos.base.v1beta1.Coin
+	(*Params)(nil),                           // 23: pickle.workqueue.v1.Params
+	(*WorkTypeDefinition)(nil),               // 24: pickle.workqueue.v1.WorkTypeDefinition
 }
 var file_workqueue_v1_tx_proto_depIdxs = []int32{
-	0, // 0: pickle.workqueue.v1.Msg.SubmitWork:input_type -> pickle.workqueue.v1.MsgSubmitWork
-	2, // 1: pickle.workqueue.v1.Msg.ValidateWork:input_type -> pickle.workqueue.v1.MsgValidateWork
-	4, // 2: pickle.workqueue.v1.Msg.RejectWork:input_type -> pickle.workqueue.v1.MsgRejectWork
-	1, // 3: pickle.workqueue.v1.Msg.SubmitWork:output_type -> pickle.workqueue.v1.MsgSubmitWorkResponse
-	3, // 4: pickle.workqueue.v1.Msg.ValidateWork:output_type -> pickle.workqueue.v1.MsgValidateWorkResponse
-	5, // 5: pickle.workqueue.v1.Msg.RejectWork:output_type -> pickle.workqueue.v1.MsgRejectWorkResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	22, // 0: pickle.workqueue.v1.MsgSubmitWork.bounty:type_name -> cosmos.base.v1beta1.Coin
+	22, // 1: pickle.workqueue.v1.MsgChallengeWork.bond:type_name -> cosmos.base.v1beta1.Coin
+	22, // 2: pickle.workqueue.v1.MsgBond.amount:type_name -> cosmos.base.v1beta1.Coin
+	22, // 3: pickle.workqueue.v1.MsgUnbond.amount:type_name -> cosmos.base.v1beta1.Coin
+	23, // 4: pickle.workqueue.v1.MsgUpdateParams.params:type_name -> pickle.workqueue.v1.Params
+	24, // 5: pickle.workqueue.v1.MsgSetWorkTypeDefinition.definition:type_name -> pickle.workqueue.v1.WorkTypeDefinition
+	0,  // 6: pickle.workqueue.v1.Msg.SubmitWork:input_type -> pickle.workqueue.v1.MsgSubmitWork
+	2,  // 7: pickle.workqueue.v1.Msg.ClaimWork:input_type -> pickle.workqueue.v1.MsgClaimWork
+	4,  // 8: pickle.workqueue.v1.Msg.ValidateWork:input_type -> pickle.workqueue.v1.MsgValidateWork
+	6,  // 9: pickle.workqueue.v1.Msg.CommitValidation:input_type -> pickle.workqueue.v1.MsgCommitValidation
+	8,  // 10: pickle.workqueue.v1.Msg.RevealValidation:input_type -> pickle.workqueue.v1.MsgRevealValidation
+	10, // 11: pickle.workqueue.v1.Msg.RejectWork:input_type -> pickle.workqueue.v1.MsgRejectWork
+	12, // 12: pickle.workqueue.v1.Msg.ChallengeWork:input_type -> pickle.workqueue.v1.MsgChallengeWork
+	14, // 13: pickle.workqueue.v1.Msg.Bond:input_type -> pickle.workqueue.v1.MsgBond
+	16, // 14: pickle.workqueue.v1.Msg.Unbond:input_type -> pickle.workqueue.v1.MsgUnbond
+	18, // 15: pickle.workqueue.v1.Msg.UpdateParams:input_type -> pickle.workqueue.v1.MsgUpdateParams
+	20, // 16: pickle.workqueue.v1.Msg.SetWorkTypeDefinition:input_type -> pickle.workqueue.v1.MsgSetWorkTypeDefinition
+	1,  // 17: pickle.workqueue.v1.Msg.SubmitWork:output_type -> pickle.workqueue.v1.MsgSubmitWorkResponse
+	3,  // 18: pickle.workqueue.v1.Msg.ClaimWork:output_type -> pickle.workqueue.v1.MsgClaimWorkResponse
+	5,  // 19: pickle.workqueue.v1.Msg.ValidateWork:output_type -> pickle.workqueue.v1.MsgValidateWorkResponse
+	7,  // 20: pickle.workqueue.v1.Msg.CommitValidation:output_type -> pickle.workqueue.v1.MsgCommitValidationResponse
+	9,  // 21: pickle.workqueue.v1.Msg.RevealValidation:output_type -> pickle.workqueue.v1.MsgRevealValidationResponse
+	11, // 22: pickle.workqueue.v1.Msg.RejectWork:output_type -> pickle.workqueue.v1.MsgRejectWorkResponse
+	13, // 23: pickle.workqueue.v1.Msg.ChallengeWork:output_type -> pickle.workqueue.v1.MsgChallengeWorkResponse
+	15, // 24: pickle.workqueue.v1.Msg.Bond:output_type -> pickle.workqueue.v1.MsgBondResponse
+	17, // 25: pickle.workqueue.v1.Msg.Unbond:output_type -> pickle.workqueue.v1.MsgUnbondResponse
+	19, // 26: pickle.workqueue.v1.Msg.UpdateParams:output_type -> pickle.workqueue.v1.MsgUpdateParamsResponse
+	21, // 27: pickle.workqueue.v1.Msg.SetWorkTypeDefinition:output_type -> pickle.workqueue.v1.MsgSetWorkTypeDefinitionResponse
+	17, // [17:28] is the sub-list for method output_type
+	6,  // [6:17] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_workqueue_v1_tx_proto_init() }
@@ -443,13 +1388,14 @@ func file_workqueue_v1_tx_proto_init() {
 	if File_workqueue_v1_tx_proto != nil {
 		return
 	}
+	file_workqueue_v1_workqueue_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workqueue_v1_tx_proto_rawDesc), len(file_workqueue_v1_tx_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
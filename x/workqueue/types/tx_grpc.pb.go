@@ -19,9 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_SubmitWork_FullMethodName   = "/pickle.workqueue.v1.Msg/SubmitWork"
-	Msg_ValidateWork_FullMethodName = "/pickle.workqueue.v1.Msg/ValidateWork"
-	Msg_RejectWork_FullMethodName   = "/pickle.workqueue.v1.Msg/RejectWork"
+	Msg_SubmitWork_FullMethodName            = "/pickle.workqueue.v1.Msg/SubmitWork"
+	Msg_ClaimWork_FullMethodName             = "/pickle.workqueue.v1.Msg/ClaimWork"
+	Msg_ValidateWork_FullMethodName          = "/pickle.workqueue.v1.Msg/ValidateWork"
+	Msg_CommitValidation_FullMethodName      = "/pickle.workqueue.v1.Msg/CommitValidation"
+	Msg_RevealValidation_FullMethodName      = "/pickle.workqueue.v1.Msg/RevealValidation"
+	Msg_RejectWork_FullMethodName            = "/pickle.workqueue.v1.Msg/RejectWork"
+	Msg_ChallengeWork_FullMethodName         = "/pickle.workqueue.v1.Msg/ChallengeWork"
+	Msg_Bond_FullMethodName                  = "/pickle.workqueue.v1.Msg/Bond"
+	Msg_Unbond_FullMethodName                = "/pickle.workqueue.v1.Msg/Unbond"
+	Msg_UpdateParams_FullMethodName          = "/pickle.workqueue.v1.Msg/UpdateParams"
+	Msg_SetWorkTypeDefinition_FullMethodName = "/pickle.workqueue.v1.Msg/SetWorkTypeDefinition"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,10 +40,30 @@ const (
 type MsgClient interface {
 	// SubmitWork submits a new work unit for validation
 	SubmitWork(ctx context.Context, in *MsgSubmitWork, opts ...grpc.CallOption) (*MsgSubmitWorkResponse, error)
+	// ClaimWork leases a pending work unit to a validator
+	ClaimWork(ctx context.Context, in *MsgClaimWork, opts ...grpc.CallOption) (*MsgClaimWorkResponse, error)
 	// ValidateWork submits a validation result for a work unit
 	ValidateWork(ctx context.Context, in *MsgValidateWork, opts ...grpc.CallOption) (*MsgValidateWorkResponse, error)
+	// CommitValidation seals a validator's verdict on a claimed work unit when
+	// commit-reveal validation is enabled
+	CommitValidation(ctx context.Context, in *MsgCommitValidation, opts ...grpc.CallOption) (*MsgCommitValidationResponse, error)
+	// RevealValidation reveals a committed verdict during the work unit's
+	// reveal phase
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
 	// RejectWork explicitly rejects a work unit
 	RejectWork(ctx context.Context, in *MsgRejectWork, opts ...grpc.CallOption) (*MsgRejectWorkResponse, error)
+	// ChallengeWork disputes the finalized outcome of a work unit
+	ChallengeWork(ctx context.Context, in *MsgChallengeWork, opts ...grpc.CallOption) (*MsgChallengeWorkResponse, error)
+	// Bond bonds stake to register as or remain a validator
+	Bond(ctx context.Context, in *MsgBond, opts ...grpc.CallOption) (*MsgBondResponse, error)
+	// Unbond starts unbonding stake from a validator's bond
+	Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error)
+	// UpdateParams updates the module parameters. It must be signed by the
+	// module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetWorkTypeDefinition registers or replaces a work type definition. It
+	// must be signed by the module authority.
+	SetWorkTypeDefinition(ctx context.Context, in *MsgSetWorkTypeDefinition, opts ...grpc.CallOption) (*MsgSetWorkTypeDefinitionResponse, error)
 }
 
 type msgClient struct {
@@ -56,6 +84,16 @@ func (c *msgClient) SubmitWork(ctx context.Context, in *MsgSubmitWork, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimWork(ctx context.Context, in *MsgClaimWork, opts ...grpc.CallOption) (*MsgClaimWorkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimWorkResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ValidateWork(ctx context.Context, in *MsgValidateWork, opts ...grpc.CallOption) (*MsgValidateWorkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgValidateWorkResponse)
@@ -66,6 +104,26 @@ func (c *msgClient) ValidateWork(ctx context.Context, in *MsgValidateWork, opts
 	return out, nil
 }
 
+func (c *msgClient) CommitValidation(ctx context.Context, in *MsgCommitValidation, opts ...grpc.CallOption) (*MsgCommitValidationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCommitValidationResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitValidation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealValidation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RejectWork(ctx context.Context, in *MsgRejectWork, opts ...grpc.CallOption) (*MsgRejectWorkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRejectWorkResponse)
@@ -76,6 +134,56 @@ func (c *msgClient) RejectWork(ctx context.Context, in *MsgRejectWork, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ChallengeWork(ctx context.Context, in *MsgChallengeWork, opts ...grpc.CallOption) (*MsgChallengeWorkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgChallengeWorkResponse)
+	err := c.cc.Invoke(ctx, Msg_ChallengeWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Bond(ctx context.Context, in *MsgBond, opts ...grpc.CallOption) (*MsgBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBondResponse)
+	err := c.cc.Invoke(ctx, Msg_Bond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnbondResponse)
+	err := c.cc.Invoke(ctx, Msg_Unbond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWorkTypeDefinition(ctx context.Context, in *MsgSetWorkTypeDefinition, opts ...grpc.CallOption) (*MsgSetWorkTypeDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetWorkTypeDefinitionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWorkTypeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -84,10 +192,30 @@ func (c *msgClient) RejectWork(ctx context.Context, in *MsgRejectWork, opts ...g
 type MsgServer interface {
 	// SubmitWork submits a new work unit for validation
 	SubmitWork(context.Context, *MsgSubmitWork) (*MsgSubmitWorkResponse, error)
+	// ClaimWork leases a pending work unit to a validator
+	ClaimWork(context.Context, *MsgClaimWork) (*MsgClaimWorkResponse, error)
 	// ValidateWork submits a validation result for a work unit
 	ValidateWork(context.Context, *MsgValidateWork) (*MsgValidateWorkResponse, error)
+	// CommitValidation seals a validator's verdict on a claimed work unit when
+	// commit-reveal validation is enabled
+	CommitValidation(context.Context, *MsgCommitValidation) (*MsgCommitValidationResponse, error)
+	// RevealValidation reveals a committed verdict during the work unit's
+	// reveal phase
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
 	// RejectWork explicitly rejects a work unit
 	RejectWork(context.Context, *MsgRejectWork) (*MsgRejectWorkResponse, error)
+	// ChallengeWork disputes the finalized outcome of a work unit
+	ChallengeWork(context.Context, *MsgChallengeWork) (*MsgChallengeWorkResponse, error)
+	// Bond bonds stake to register as or remain a validator
+	Bond(context.Context, *MsgBond) (*MsgBondResponse, error)
+	// Unbond starts unbonding stake from a validator's bond
+	Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error)
+	// UpdateParams updates the module parameters. It must be signed by the
+	// module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetWorkTypeDefinition registers or replaces a work type definition. It
+	// must be signed by the module authority.
+	SetWorkTypeDefinition(context.Context, *MsgSetWorkTypeDefinition) (*MsgSetWorkTypeDefinitionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,12 +229,36 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) SubmitWork(context.Context, *MsgSubmitWork) (*MsgSubmitWorkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitWork not implemented")
 }
+func (UnimplementedMsgServer) ClaimWork(context.Context, *MsgClaimWork) (*MsgClaimWorkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimWork not implemented")
+}
 func (UnimplementedMsgServer) ValidateWork(context.Context, *MsgValidateWork) (*MsgValidateWorkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateWork not implemented")
 }
+func (UnimplementedMsgServer) CommitValidation(context.Context, *MsgCommitValidation) (*MsgCommitValidationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitValidation not implemented")
+}
+func (UnimplementedMsgServer) RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevealValidation not implemented")
+}
 func (UnimplementedMsgServer) RejectWork(context.Context, *MsgRejectWork) (*MsgRejectWorkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RejectWork not implemented")
 }
+func (UnimplementedMsgServer) ChallengeWork(context.Context, *MsgChallengeWork) (*MsgChallengeWorkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChallengeWork not implemented")
+}
+func (UnimplementedMsgServer) Bond(context.Context, *MsgBond) (*MsgBondResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Bond not implemented")
+}
+func (UnimplementedMsgServer) Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unbond not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) SetWorkTypeDefinition(context.Context, *MsgSetWorkTypeDefinition) (*MsgSetWorkTypeDefinitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetWorkTypeDefinition not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -146,6 +298,24 @@ func _Msg_SubmitWork_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimWork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimWork(ctx, req.(*MsgClaimWork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ValidateWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgValidateWork)
 	if err := dec(in); err != nil {
@@ -164,6 +334,42 @@ func _Msg_ValidateWork_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitValidation(ctx, req.(*MsgCommitValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RejectWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRejectWork)
 	if err := dec(in); err != nil {
@@ -182,6 +388,96 @@ func _Msg_RejectWork_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChallengeWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeWork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChallengeWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeWork(ctx, req.(*MsgChallengeWork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Bond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Bond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Bond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Bond(ctx, req.(*MsgBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unbond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unbond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unbond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unbond(ctx, req.(*MsgUnbond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWorkTypeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWorkTypeDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWorkTypeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWorkTypeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWorkTypeDefinition(ctx, req.(*MsgSetWorkTypeDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,14 +489,46 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitWork",
 			Handler:    _Msg_SubmitWork_Handler,
 		},
+		{
+			MethodName: "ClaimWork",
+			Handler:    _Msg_ClaimWork_Handler,
+		},
 		{
 			MethodName: "ValidateWork",
 			Handler:    _Msg_ValidateWork_Handler,
 		},
+		{
+			MethodName: "CommitValidation",
+			Handler:    _Msg_CommitValidation_Handler,
+		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
 		{
 			MethodName: "RejectWork",
 			Handler:    _Msg_RejectWork_Handler,
 		},
+		{
+			MethodName: "ChallengeWork",
+			Handler:    _Msg_ChallengeWork_Handler,
+		},
+		{
+			MethodName: "Bond",
+			Handler:    _Msg_Bond_Handler,
+		},
+		{
+			MethodName: "Unbond",
+			Handler:    _Msg_Unbond_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetWorkTypeDefinition",
+			Handler:    _Msg_SetWorkTypeDefinition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workqueue/v1/tx.proto",
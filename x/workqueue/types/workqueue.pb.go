@@ -7,6 +7,7 @@
 package types
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -41,7 +42,36 @@ type WorkUnit struct {
 	// Confidence is the validator's confidence in the result (0-100)
 	Confidence uint32 `protobuf:"varint,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
 	// Proof is optional proof of validation
-	Proof         string `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+	Proof string `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Submitter is the address that submitted the work
+	Submitter string `protobuf:"bytes,10,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// ClaimedBy is the validator currently holding the lease on this work
+	ClaimedBy string `protobuf:"bytes,11,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	// LeaseExpiresAt is the last block height at which the lease holder may
+	// submit a result before the work returns to pending
+	LeaseExpiresAt int64 `protobuf:"varint,12,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	// ExpiresAt is the block height by which the work must be finalized before
+	// it expires, fixed at submission
+	ExpiresAt int64 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Priority is the scheduling priority requested by the submitter, higher
+	// values are served first
+	Priority uint32 `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	// ScheduledAt is the virtual submission height used to order pending work:
+	// the submission height brought forward by the aging period for each
+	// priority level. Pending work is served in ascending ScheduledAt order.
+	ScheduledAt int64 `protobuf:"varint,15,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// AssignedTo is the validator the module last assigned the work to, empty
+	// if the work was only ever claimed directly
+	AssignedTo string `protobuf:"bytes,16,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	// AssignedAt is the block height of the last assignment
+	AssignedAt int64 `protobuf:"varint,17,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	// RevealEndsAt is the last block height at which committed verdicts can be
+	// revealed while the work is in its reveal phase
+	RevealEndsAt int64 `protobuf:"varint,18,opt,name=reveal_ends_at,json=revealEndsAt,proto3" json:"reveal_ends_at,omitempty"`
+	// CommitEndsAt is the last block height at which verdicts can be committed
+	// in the current round, set by its first commitment. The reveal phase opens
+	// once it passes, or earlier if the round fills.
+	CommitEndsAt  int64 `protobuf:"varint,19,opt,name=commit_ends_at,json=commitEndsAt,proto3" json:"commit_ends_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -139,6 +169,76 @@ func (x *WorkUnit) GetProof() string {
 	return ""
 }
 
+func (x *WorkUnit) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *WorkUnit) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *WorkUnit) GetLeaseExpiresAt() int64 {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetScheduledAt() int64 {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *WorkUnit) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetRevealEndsAt() int64 {
+	if x != nil {
+		return x.RevealEndsAt
+	}
+	return 0
+}
+
+func (x *WorkUnit) GetCommitEndsAt() int64 {
+	if x != nil {
+		return x.CommitEndsAt
+	}
+	return 0
+}
+
 // ValidatorStats tracks performance metrics for a validator
 type ValidatorStats struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -153,26 +253,826 @@ type ValidatorStats struct {
 	// AverageConfidence is the average confidence of validations
 	AverageConfidence uint32 `protobuf:"varint,5,opt,name=average_confidence,json=averageConfidence,proto3" json:"average_confidence,omitempty"`
 	// LastActiveAt is the block height when last active
-	LastActiveAt  int64 `protobuf:"varint,6,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	LastActiveAt int64 `protobuf:"varint,6,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	// TotalOverturned is the number of the validator's votes overturned by a
+	// successful challenge
+	TotalOverturned uint64 `protobuf:"varint,7,opt,name=total_overturned,json=totalOverturned,proto3" json:"total_overturned,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidatorStats) Reset() {
+	*x = ValidatorStats{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStats) ProtoMessage() {}
+
+func (x *ValidatorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStats.ProtoReflect.Descriptor instead.
+func (*ValidatorStats) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorStats) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorStats) GetTotalWorkValidated() uint64 {
+	if x != nil {
+		return x.TotalWorkValidated
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetTotalWorkRejected() uint64 {
+	if x != nil {
+		return x.TotalWorkRejected
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetSpecializations() map[string]uint64 {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+func (x *ValidatorStats) GetAverageConfidence() uint32 {
+	if x != nil {
+		return x.AverageConfidence
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetLastActiveAt() int64 {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetTotalOverturned() uint64 {
+	if x != nil {
+		return x.TotalOverturned
+	}
+	return 0
+}
+
+// WorkVote is a single validator's verdict on a work unit
+type WorkVote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkID is the ID of the work unit voted on
+	WorkId string `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Validator is the address of the voting validator
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Valid indicates whether the validator found the work valid
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Confidence is the validator's confidence in the verdict (0-100)
+	Confidence uint32 `protobuf:"varint,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// Proof is optional proof of validation, or the rejection reason
+	Proof string `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	// VotedAt is the block height when the vote was cast
+	VotedAt       int64 `protobuf:"varint,6,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkVote) Reset() {
+	*x = WorkVote{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkVote) ProtoMessage() {}
+
+func (x *WorkVote) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkVote.ProtoReflect.Descriptor instead.
+func (*WorkVote) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkVote) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *WorkVote) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *WorkVote) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *WorkVote) GetConfidence() uint32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *WorkVote) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+func (x *WorkVote) GetVotedAt() int64 {
+	if x != nil {
+		return x.VotedAt
+	}
+	return 0
+}
+
+// ValidationCommit is a validator's sealed verdict on a work unit, revealed
+// once every verdict of the round is committed
+type ValidationCommit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkID is the ID of the work unit the verdict is on
+	WorkId string `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Validator is the address of the committing validator
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Commitment is the hash of the verdict, see ValidationCommitment
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// CommittedAt is the block height when the commitment was made
+	CommittedAt   int64 `protobuf:"varint,4,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidatorStats) Reset() {
-	*x = ValidatorStats{}
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[1]
+func (x *ValidationCommit) Reset() {
+	*x = ValidationCommit{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationCommit) ProtoMessage() {}
+
+func (x *ValidationCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationCommit.ProtoReflect.Descriptor instead.
+func (*ValidationCommit) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidationCommit) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *ValidationCommit) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidationCommit) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *ValidationCommit) GetCommittedAt() int64 {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return 0
+}
+
+// QuorumRule defines how many votes a work type needs before it is finalized
+type QuorumRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkType is the work type the rule applies to
+	WorkType string `protobuf:"bytes,1,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	// RequiredVotes is the number of votes (N) collected before finalizing
+	RequiredVotes uint32 `protobuf:"varint,2,opt,name=required_votes,json=requiredVotes,proto3" json:"required_votes,omitempty"`
+	// RequiredAgreement is the number of valid votes (M of N) needed to
+	// finalize the work as validated
+	RequiredAgreement uint32 `protobuf:"varint,3,opt,name=required_agreement,json=requiredAgreement,proto3" json:"required_agreement,omitempty"`
+	// MinAverageConfidence is the minimum average confidence of the valid
+	// votes needed to finalize the work as validated
+	MinAverageConfidence uint32 `protobuf:"varint,4,opt,name=min_average_confidence,json=minAverageConfidence,proto3" json:"min_average_confidence,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuorumRule) Reset() {
+	*x = QuorumRule{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuorumRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumRule) ProtoMessage() {}
+
+func (x *QuorumRule) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumRule.ProtoReflect.Descriptor instead.
+func (*QuorumRule) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuorumRule) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+func (x *QuorumRule) GetRequiredVotes() uint32 {
+	if x != nil {
+		return x.RequiredVotes
+	}
+	return 0
+}
+
+func (x *QuorumRule) GetRequiredAgreement() uint32 {
+	if x != nil {
+		return x.RequiredAgreement
+	}
+	return 0
+}
+
+func (x *QuorumRule) GetMinAverageConfidence() uint32 {
+	if x != nil {
+		return x.MinAverageConfidence
+	}
+	return 0
+}
+
+// WorkTypeDefinition registers a work type that may be submitted to the queue
+type WorkTypeDefinition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the work type, matched against WorkUnit.type
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Schema is a JSON schema that submitted data must satisfy, empty to accept
+	// any data
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// MaxDataSize is the maximum size in bytes of submitted data, zero to use
+	// the module-wide limit
+	MaxDataSize uint64 `protobuf:"varint,3,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	// QuorumRule is the quorum work of this type must reach, unset to use the
+	// default rule from the module parameters
+	QuorumRule *QuorumRule `protobuf:"bytes,4,opt,name=quorum_rule,json=quorumRule,proto3" json:"quorum_rule,omitempty"`
+	// Enabled reports whether new work of this type is accepted
+	Enabled       bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkTypeDefinition) Reset() {
+	*x = WorkTypeDefinition{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkTypeDefinition) ProtoMessage() {}
+
+func (x *WorkTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkTypeDefinition.ProtoReflect.Descriptor instead.
+func (*WorkTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WorkTypeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkTypeDefinition) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *WorkTypeDefinition) GetMaxDataSize() uint64 {
+	if x != nil {
+		return x.MaxDataSize
+	}
+	return 0
+}
+
+func (x *WorkTypeDefinition) GetQuorumRule() *QuorumRule {
+	if x != nil {
+		return x.QuorumRule
+	}
+	return nil
+}
+
+func (x *WorkTypeDefinition) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// VoteTally summarizes the votes cast on a work unit against its quorum rule
+type VoteTally struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ValidVotes is the number of votes finding the work valid
+	ValidVotes uint32 `protobuf:"varint,1,opt,name=valid_votes,json=validVotes,proto3" json:"valid_votes,omitempty"`
+	// InvalidVotes is the number of votes finding the work invalid
+	InvalidVotes uint32 `protobuf:"varint,2,opt,name=invalid_votes,json=invalidVotes,proto3" json:"invalid_votes,omitempty"`
+	// AverageValidConfidence is the average confidence of the valid votes
+	AverageValidConfidence uint32 `protobuf:"varint,3,opt,name=average_valid_confidence,json=averageValidConfidence,proto3" json:"average_valid_confidence,omitempty"`
+	// Rule is the quorum rule the work is finalized under
+	Rule *QuorumRule `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	// AverageInvalidConfidence is the average confidence of the invalid votes
+	AverageInvalidConfidence uint32 `protobuf:"varint,5,opt,name=average_invalid_confidence,json=averageInvalidConfidence,proto3" json:"average_invalid_confidence,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *VoteTally) Reset() {
+	*x = VoteTally{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteTally) ProtoMessage() {}
+
+func (x *VoteTally) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteTally.ProtoReflect.Descriptor instead.
+func (*VoteTally) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VoteTally) GetValidVotes() uint32 {
+	if x != nil {
+		return x.ValidVotes
+	}
+	return 0
+}
+
+func (x *VoteTally) GetInvalidVotes() uint32 {
+	if x != nil {
+		return x.InvalidVotes
+	}
+	return 0
+}
+
+func (x *VoteTally) GetAverageValidConfidence() uint32 {
+	if x != nil {
+		return x.AverageValidConfidence
+	}
+	return 0
+}
+
+func (x *VoteTally) GetRule() *QuorumRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *VoteTally) GetAverageInvalidConfidence() uint32 {
+	if x != nil {
+		return x.AverageInvalidConfidence
+	}
+	return 0
+}
+
+// Challenge disputes the finalized outcome of a work unit. The work is
+// re-validated by validators who did not vote in the original round.
+type Challenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkID is the ID of the challenged work unit
+	WorkId string `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Challenger is the address that raised the challenge
+	Challenger string `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// Bond is the amount escrowed by the challenger
+	Bond *v1beta1.Coin `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	// Reason explains why the outcome is disputed
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// CreatedAt is the block height when the challenge was raised
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// OriginalStatus is the finalized status being disputed
+	OriginalStatus string `protobuf:"bytes,6,opt,name=original_status,json=originalStatus,proto3" json:"original_status,omitempty"`
+	// Resolved indicates the re-validation round has finished
+	Resolved bool `protobuf:"varint,7,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// Overturned indicates the re-validation round reversed the original status
+	Overturned bool `protobuf:"varint,8,opt,name=overturned,proto3" json:"overturned,omitempty"`
+	// ResolvedAt is the block height when the challenge was resolved
+	ResolvedAt    int64 `protobuf:"varint,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Challenge) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *Challenge) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *Challenge) GetBond() *v1beta1.Coin {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *Challenge) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Challenge) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Challenge) GetOriginalStatus() string {
+	if x != nil {
+		return x.OriginalStatus
+	}
+	return ""
+}
+
+func (x *Challenge) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *Challenge) GetOverturned() bool {
+	if x != nil {
+		return x.Overturned
+	}
+	return false
+}
+
+func (x *Challenge) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+// Bounty is a payment escrowed with a work unit. It is paid to the validators
+// who validate the work, or returned to the submitter when the work is
+// rejected or expires.
+type Bounty struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WorkID is the ID of the work unit the bounty is attached to
+	WorkId string `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Submitter is the address that escrowed the bounty
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// Amount is the escrowed amount
+	Amount *v1beta1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Status is escrowed, paid or refunded
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Burned is the part of the bounty burned when the work was rejected
+	Burned *v1beta1.Coin `protobuf:"bytes,5,opt,name=burned,proto3" json:"burned,omitempty"`
+	// SettledAt is the block height at which the bounty was paid or refunded
+	SettledAt int64 `protobuf:"varint,6,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	// SettlesAt is the block height at whose end the bounty of finalized work
+	// is settled, once its outcome can no longer be challenged. It is zero
+	// while the work is open or under challenge.
+	SettlesAt     int64 `protobuf:"varint,7,opt,name=settles_at,json=settlesAt,proto3" json:"settles_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bounty) Reset() {
+	*x = Bounty{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bounty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bounty) ProtoMessage() {}
+
+func (x *Bounty) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bounty.ProtoReflect.Descriptor instead.
+func (*Bounty) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Bounty) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *Bounty) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *Bounty) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Bounty) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Bounty) GetBurned() *v1beta1.Coin {
+	if x != nil {
+		return x.Burned
+	}
+	return nil
+}
+
+func (x *Bounty) GetSettledAt() int64 {
+	if x != nil {
+		return x.SettledAt
+	}
+	return 0
+}
+
+func (x *Bounty) GetSettlesAt() int64 {
+	if x != nil {
+		return x.SettlesAt
+	}
+	return 0
+}
+
+// ValidatorBond is the stake a validator has bonded to the workqueue module
+type ValidatorBond struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the bonded validator's address
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Amount is the currently bonded stake
+	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// SlashCount is the number of times the validator has been slashed
+	SlashCount uint64 `protobuf:"varint,3,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// JailedUntil is the block height until which the validator may not claim
+	// or vote on work
+	JailedUntil   int64 `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorBond) Reset() {
+	*x = ValidatorBond{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorBond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorBond) ProtoMessage() {}
+
+func (x *ValidatorBond) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorBond.ProtoReflect.Descriptor instead.
+func (*ValidatorBond) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidatorBond) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorBond) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ValidatorBond) GetSlashCount() uint64 {
+	if x != nil {
+		return x.SlashCount
+	}
+	return 0
+}
+
+func (x *ValidatorBond) GetJailedUntil() int64 {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return 0
+}
+
+// UnbondingEntry is stake leaving a validator's bond once the unbonding
+// period completes
+type UnbondingEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validator is the unbonding validator's address
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Amount is the stake being unbonded
+	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// CompletionHeight is the block height at which the stake is returned
+	CompletionHeight int64 `protobuf:"varint,3,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnbondingEntry) Reset() {
+	*x = UnbondingEntry{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatorStats) String() string {
+func (x *UnbondingEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorStats) ProtoMessage() {}
+func (*UnbondingEntry) ProtoMessage() {}
 
-func (x *ValidatorStats) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[1]
+func (x *UnbondingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,49 +1083,28 @@ func (x *ValidatorStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorStats.ProtoReflect.Descriptor instead.
-func (*ValidatorStats) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UnbondingEntry.ProtoReflect.Descriptor instead.
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ValidatorStats) GetAddress() string {
+func (x *UnbondingEntry) GetValidator() string {
 	if x != nil {
-		return x.Address
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *ValidatorStats) GetTotalWorkValidated() uint64 {
-	if x != nil {
-		return x.TotalWorkValidated
-	}
-	return 0
-}
-
-func (x *ValidatorStats) GetTotalWorkRejected() uint64 {
+func (x *UnbondingEntry) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.TotalWorkRejected
-	}
-	return 0
-}
-
-func (x *ValidatorStats) GetSpecializations() map[string]uint64 {
-	if x != nil {
-		return x.Specializations
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *ValidatorStats) GetAverageConfidence() uint32 {
-	if x != nil {
-		return x.AverageConfidence
-	}
-	return 0
-}
-
-func (x *ValidatorStats) GetLastActiveAt() int64 {
+func (x *UnbondingEntry) GetCompletionHeight() int64 {
 	if x != nil {
-		return x.LastActiveAt
+		return x.CompletionHeight
 	}
 	return 0
 }
@@ -233,7 +1112,8 @@ func (x *ValidatorStats) GetLastActiveAt() int64 {
 // WorkQueue stores the queue of work units
 type WorkQueue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// PendingWork is a list of work units waiting to be validated
+	// PendingWork is a list of new work units submitted at genesis. Exported
+	// state carries stored work in GenesisState.work instead.
 	PendingWork []*WorkUnit `protobuf:"bytes,1,rep,name=pending_work,json=pendingWork,proto3" json:"pending_work,omitempty"`
 	// TotalSubmitted is the total number of work units ever submitted
 	TotalSubmitted uint64 `protobuf:"varint,2,opt,name=total_submitted,json=totalSubmitted,proto3" json:"total_submitted,omitempty"`
@@ -247,7 +1127,7 @@ type WorkQueue struct {
 
 func (x *WorkQueue) Reset() {
 	*x = WorkQueue{}
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[2]
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +1139,7 @@ func (x *WorkQueue) String() string {
 func (*WorkQueue) ProtoMessage() {}
 
 func (x *WorkQueue) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[2]
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +1152,7 @@ func (x *WorkQueue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkQueue.ProtoReflect.Descriptor instead.
 func (*WorkQueue) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{2}
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WorkQueue) GetPendingWork() []*WorkUnit {
@@ -303,20 +1183,309 @@ func (x *WorkQueue) GetTotalRejected() uint64 {
 	return 0
 }
 
+// Params defines the tunable parameters of the workqueue module
+type Params struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MaxDataSize is the maximum size in bytes of submitted work data, zero for
+	// no limit
+	MaxDataSize uint64 `protobuf:"varint,1,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	// DefaultRequiredVotes is the number of votes needed to finalize work types
+	// without their own quorum rule
+	DefaultRequiredVotes uint32 `protobuf:"varint,3,opt,name=default_required_votes,json=defaultRequiredVotes,proto3" json:"default_required_votes,omitempty"`
+	// DefaultRequiredAgreement is the number of valid votes needed to accept
+	// work types without their own quorum rule
+	DefaultRequiredAgreement uint32 `protobuf:"varint,4,opt,name=default_required_agreement,json=defaultRequiredAgreement,proto3" json:"default_required_agreement,omitempty"`
+	// DefaultMinAverageConfidence is the minimum average confidence of valid
+	// votes for work types without their own quorum rule
+	DefaultMinAverageConfidence uint32 `protobuf:"varint,5,opt,name=default_min_average_confidence,json=defaultMinAverageConfidence,proto3" json:"default_min_average_confidence,omitempty"`
+	// LeaseBlocks is the number of blocks a claim stays leased to its validator
+	LeaseBlocks int64 `protobuf:"varint,6,opt,name=lease_blocks,json=leaseBlocks,proto3" json:"lease_blocks,omitempty"`
+	// WorkExpiryBlocks is the number of blocks after submission by which work
+	// must be finalized before it expires
+	WorkExpiryBlocks int64 `protobuf:"varint,7,opt,name=work_expiry_blocks,json=workExpiryBlocks,proto3" json:"work_expiry_blocks,omitempty"`
+	// ChallengeWindow is the number of blocks after finalization during which
+	// an outcome can be challenged, and after a challenge during which its
+	// re-validation round must reach quorum
+	ChallengeWindow int64 `protobuf:"varint,8,opt,name=challenge_window,json=challengeWindow,proto3" json:"challenge_window,omitempty"`
+	// BondDenom is the denomination challenge and validator bonds are posted in
+	BondDenom string `protobuf:"bytes,9,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// MinChallengeBond is the minimum bond required to challenge work
+	MinChallengeBond string `protobuf:"bytes,10,opt,name=min_challenge_bond,json=minChallengeBond,proto3" json:"min_challenge_bond,omitempty"`
+	// MinValidatorBond is the minimum bond a validator must hold to claim and
+	// vote on work
+	MinValidatorBond string `protobuf:"bytes,11,opt,name=min_validator_bond,json=minValidatorBond,proto3" json:"min_validator_bond,omitempty"`
+	// UnbondingBlocks is the number of blocks unbonding stake stays slashable
+	// before it is returned
+	UnbondingBlocks int64 `protobuf:"varint,12,opt,name=unbonding_blocks,json=unbondingBlocks,proto3" json:"unbonding_blocks,omitempty"`
+	// SlashFraction is the fraction of bonded and unbonding stake burned each
+	// time a validator is overturned
+	SlashFraction string `protobuf:"bytes,13,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// JailThreshold is the number of slashes after which a validator is jailed,
+	// and again for every further multiple
+	JailThreshold uint64 `protobuf:"varint,14,opt,name=jail_threshold,json=jailThreshold,proto3" json:"jail_threshold,omitempty"`
+	// JailBlocks is the number of blocks a jailed validator may not claim or
+	// vote on work
+	JailBlocks int64 `protobuf:"varint,15,opt,name=jail_blocks,json=jailBlocks,proto3" json:"jail_blocks,omitempty"`
+	// BountyBurnFraction is the fraction of a bounty burned when its work is
+	// rejected
+	BountyBurnFraction string `protobuf:"bytes,16,opt,name=bounty_burn_fraction,json=bountyBurnFraction,proto3" json:"bounty_burn_fraction,omitempty"`
+	// MaxPriority is the highest priority work may be submitted with
+	MaxPriority uint32 `protobuf:"varint,17,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty"`
+	// PriorityAgingBlocks is the number of blocks pending work must wait to
+	// gain the equivalent of one priority level, so low priority work cannot
+	// starve
+	PriorityAgingBlocks int64 `protobuf:"varint,18,opt,name=priority_aging_blocks,json=priorityAgingBlocks,proto3" json:"priority_aging_blocks,omitempty"`
+	// AssignmentEnabled turns on assignment of pending work to validators in
+	// the end blocker
+	AssignmentEnabled bool `protobuf:"varint,19,opt,name=assignment_enabled,json=assignmentEnabled,proto3" json:"assignment_enabled,omitempty"`
+	// MaxAssignmentsPerBlock is the number of highest priority pending units
+	// considered for assignment each block
+	MaxAssignmentsPerBlock uint32 `protobuf:"varint,20,opt,name=max_assignments_per_block,json=maxAssignmentsPerBlock,proto3" json:"max_assignments_per_block,omitempty"`
+	// MaxValidatorLoad is the number of leased units a validator may hold before
+	// it is no longer assigned work
+	MaxValidatorLoad uint32 `protobuf:"varint,21,opt,name=max_validator_load,json=maxValidatorLoad,proto3" json:"max_validator_load,omitempty"`
+	// CommitRevealEnabled requires validators to commit to a sealed verdict and
+	// reveal it once the round is full or its commit window closes instead of
+	// voting directly
+	CommitRevealEnabled bool `protobuf:"varint,22,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty"`
+	// RevealBlocks is the number of blocks validators have to reveal their
+	// committed verdicts before unrevealed commitments are slashed
+	RevealBlocks int64 `protobuf:"varint,23,opt,name=reveal_blocks,json=revealBlocks,proto3" json:"reveal_blocks,omitempty"`
+	// CommitBlocks is the number of blocks after the first commitment of a
+	// round during which further verdicts can be committed before the reveal
+	// phase opens
+	CommitBlocks  int64 `protobuf:"varint,24,opt,name=commit_blocks,json=commitBlocks,proto3" json:"commit_blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Params) GetMaxDataSize() uint64 {
+	if x != nil {
+		return x.MaxDataSize
+	}
+	return 0
+}
+
+func (x *Params) GetDefaultRequiredVotes() uint32 {
+	if x != nil {
+		return x.DefaultRequiredVotes
+	}
+	return 0
+}
+
+func (x *Params) GetDefaultRequiredAgreement() uint32 {
+	if x != nil {
+		return x.DefaultRequiredAgreement
+	}
+	return 0
+}
+
+func (x *Params) GetDefaultMinAverageConfidence() uint32 {
+	if x != nil {
+		return x.DefaultMinAverageConfidence
+	}
+	return 0
+}
+
+func (x *Params) GetLeaseBlocks() int64 {
+	if x != nil {
+		return x.LeaseBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetWorkExpiryBlocks() int64 {
+	if x != nil {
+		return x.WorkExpiryBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetChallengeWindow() int64 {
+	if x != nil {
+		return x.ChallengeWindow
+	}
+	return 0
+}
+
+func (x *Params) GetBondDenom() string {
+	if x != nil {
+		return x.BondDenom
+	}
+	return ""
+}
+
+func (x *Params) GetMinChallengeBond() string {
+	if x != nil {
+		return x.MinChallengeBond
+	}
+	return ""
+}
+
+func (x *Params) GetMinValidatorBond() string {
+	if x != nil {
+		return x.MinValidatorBond
+	}
+	return ""
+}
+
+func (x *Params) GetUnbondingBlocks() int64 {
+	if x != nil {
+		return x.UnbondingBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetJailThreshold() uint64 {
+	if x != nil {
+		return x.JailThreshold
+	}
+	return 0
+}
+
+func (x *Params) GetJailBlocks() int64 {
+	if x != nil {
+		return x.JailBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetBountyBurnFraction() string {
+	if x != nil {
+		return x.BountyBurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetMaxPriority() uint32 {
+	if x != nil {
+		return x.MaxPriority
+	}
+	return 0
+}
+
+func (x *Params) GetPriorityAgingBlocks() int64 {
+	if x != nil {
+		return x.PriorityAgingBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetAssignmentEnabled() bool {
+	if x != nil {
+		return x.AssignmentEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxAssignmentsPerBlock() uint32 {
+	if x != nil {
+		return x.MaxAssignmentsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxValidatorLoad() uint32 {
+	if x != nil {
+		return x.MaxValidatorLoad
+	}
+	return 0
+}
+
+func (x *Params) GetCommitRevealEnabled() bool {
+	if x != nil {
+		return x.CommitRevealEnabled
+	}
+	return false
+}
+
+func (x *Params) GetRevealBlocks() int64 {
+	if x != nil {
+		return x.RevealBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetCommitBlocks() int64 {
+	if x != nil {
+		return x.CommitBlocks
+	}
+	return 0
+}
+
 // GenesisState defines the initial state of the workqueue module
 type GenesisState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// WorkQueue is the initial work queue state
 	WorkQueue *WorkQueue `protobuf:"bytes,1,opt,name=work_queue,json=workQueue,proto3" json:"work_queue,omitempty"`
 	// Validators is the list of initial validators with their stats
-	Validators    []*ValidatorStats `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Validators []*ValidatorStats `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	// QuorumRules is the list of per work type quorum rules
+	QuorumRules []*QuorumRule `protobuf:"bytes,3,rep,name=quorum_rules,json=quorumRules,proto3" json:"quorum_rules,omitempty"`
+	// Bonds is the list of validator bonds held by the module account
+	Bonds []*ValidatorBond `protobuf:"bytes,4,rep,name=bonds,proto3" json:"bonds,omitempty"`
+	// Unbonding is the list of unbonding entries awaiting completion
+	Unbonding []*UnbondingEntry `protobuf:"bytes,5,rep,name=unbonding,proto3" json:"unbonding,omitempty"`
+	// Bounties is the list of bounties attached to work units
+	Bounties []*Bounty `protobuf:"bytes,6,rep,name=bounties,proto3" json:"bounties,omitempty"`
+	// Params is the module configuration
+	Params *Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`
+	// WorkTypes is the registry of work types that may be submitted
+	WorkTypes []*WorkTypeDefinition `protobuf:"bytes,8,rep,name=work_types,json=workTypes,proto3" json:"work_types,omitempty"`
+	// Commits is the list of unrevealed validation commitments
+	Commits []*ValidationCommit `protobuf:"bytes,9,rep,name=commits,proto3" json:"commits,omitempty"`
+	// Work is the list of stored work units in every status, imported as is
+	Work []*WorkUnit `protobuf:"bytes,10,rep,name=work,proto3" json:"work,omitempty"`
+	// Votes is the list of votes cast on work units
+	Votes []*WorkVote `protobuf:"bytes,11,rep,name=votes,proto3" json:"votes,omitempty"`
+	// Challenges is the list of challenges raised against work units
+	Challenges []*Challenge `protobuf:"bytes,12,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	// ChallengeVotes is the list of re-validation votes on challenged work units
+	ChallengeVotes []*WorkVote `protobuf:"bytes,13,rep,name=challenge_votes,json=challengeVotes,proto3" json:"challenge_votes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GenesisState) Reset() {
 	*x = GenesisState{}
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[3]
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +1497,7 @@ func (x *GenesisState) String() string {
 func (*GenesisState) ProtoMessage() {}
 
 func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	mi := &file_workqueue_v1_workqueue_proto_msgTypes[3]
+	mi := &file_workqueue_v1_workqueue_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +1510,7 @@ func (x *GenesisState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{3}
+	return file_workqueue_v1_workqueue_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GenesisState) GetWorkQueue() *WorkQueue {
@@ -358,11 +1527,88 @@ func (x *GenesisState) GetValidators() []*ValidatorStats {
 	return nil
 }
 
+func (x *GenesisState) GetQuorumRules() []*QuorumRule {
+	if x != nil {
+		return x.QuorumRules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBonds() []*ValidatorBond {
+	if x != nil {
+		return x.Bonds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnbonding() []*UnbondingEntry {
+	if x != nil {
+		return x.Unbonding
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBounties() []*Bounty {
+	if x != nil {
+		return x.Bounties
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWorkTypes() []*WorkTypeDefinition {
+	if x != nil {
+		return x.WorkTypes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCommits() []*ValidationCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWork() []*WorkUnit {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVotes() []*WorkVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChallengeVotes() []*WorkVote {
+	if x != nil {
+		return x.ChallengeVotes
+	}
+	return nil
+}
+
 var File_workqueue_v1_workqueue_proto protoreflect.FileDescriptor
 
 const file_workqueue_v1_workqueue_proto_rawDesc = "" +
 	"\n" +
-	"\x1cworkqueue/v1/workqueue.proto\x12\x13pickle.workqueue.v1\"\xf4\x01\n" +
+	"\x1cworkqueue/v1/workqueue.proto\x12\x13pickle.workqueue.v1\x1a\x1ecosmos/base/v1beta1/coin.proto\"\xc7\x04\n" +
 	"\bWorkUnit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
@@ -374,28 +1620,158 @@ const file_workqueue_v1_workqueue_proto_rawDesc = "" +
 	"\n" +
 	"confidence\x18\b \x01(\rR\n" +
 	"confidence\x12\x14\n" +
-	"\x05proof\x18\t \x01(\tR\x05proof\"\x89\x03\n" +
+	"\x05proof\x18\t \x01(\tR\x05proof\x12\x1c\n" +
+	"\tsubmitter\x18\n" +
+	" \x01(\tR\tsubmitter\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\v \x01(\tR\tclaimedBy\x12(\n" +
+	"\x10lease_expires_at\x18\f \x01(\x03R\x0eleaseExpiresAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\rR\bpriority\x12!\n" +
+	"\fscheduled_at\x18\x0f \x01(\x03R\vscheduledAt\x12\x1f\n" +
+	"\vassigned_to\x18\x10 \x01(\tR\n" +
+	"assignedTo\x12\x1f\n" +
+	"\vassigned_at\x18\x11 \x01(\x03R\n" +
+	"assignedAt\x12$\n" +
+	"\x0ereveal_ends_at\x18\x12 \x01(\x03R\frevealEndsAt\x12$\n" +
+	"\x0ecommit_ends_at\x18\x13 \x01(\x03R\fcommitEndsAt\"\xb4\x03\n" +
 	"\x0eValidatorStats\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x120\n" +
 	"\x14total_work_validated\x18\x02 \x01(\x04R\x12totalWorkValidated\x12.\n" +
 	"\x13total_work_rejected\x18\x03 \x01(\x04R\x11totalWorkRejected\x12b\n" +
 	"\x0fspecializations\x18\x04 \x03(\v28.pickle.workqueue.v1.ValidatorStats.SpecializationsEntryR\x0fspecializations\x12-\n" +
 	"\x12average_confidence\x18\x05 \x01(\rR\x11averageConfidence\x12$\n" +
-	"\x0elast_active_at\x18\x06 \x01(\x03R\flastActiveAt\x1aB\n" +
+	"\x0elast_active_at\x18\x06 \x01(\x03R\flastActiveAt\x12)\n" +
+	"\x10total_overturned\x18\a \x01(\x04R\x0ftotalOverturned\x1aB\n" +
 	"\x14SpecializationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\xc6\x01\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\xa8\x01\n" +
+	"\bWorkVote\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\rR\n" +
+	"confidence\x12\x14\n" +
+	"\x05proof\x18\x05 \x01(\tR\x05proof\x12\x19\n" +
+	"\bvoted_at\x18\x06 \x01(\x03R\avotedAt\"\x8c\x01\n" +
+	"\x10ValidationCommit\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\fR\n" +
+	"commitment\x12!\n" +
+	"\fcommitted_at\x18\x04 \x01(\x03R\vcommittedAt\"\xb5\x01\n" +
+	"\n" +
+	"QuorumRule\x12\x1b\n" +
+	"\twork_type\x18\x01 \x01(\tR\bworkType\x12%\n" +
+	"\x0erequired_votes\x18\x02 \x01(\rR\rrequiredVotes\x12-\n" +
+	"\x12required_agreement\x18\x03 \x01(\rR\x11requiredAgreement\x124\n" +
+	"\x16min_average_confidence\x18\x04 \x01(\rR\x14minAverageConfidence\"\xc0\x01\n" +
+	"\x12WorkTypeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\"\n" +
+	"\rmax_data_size\x18\x03 \x01(\x04R\vmaxDataSize\x12@\n" +
+	"\vquorum_rule\x18\x04 \x01(\v2\x1f.pickle.workqueue.v1.QuorumRuleR\n" +
+	"quorumRule\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\xfe\x01\n" +
+	"\tVoteTally\x12\x1f\n" +
+	"\vvalid_votes\x18\x01 \x01(\rR\n" +
+	"validVotes\x12#\n" +
+	"\rinvalid_votes\x18\x02 \x01(\rR\finvalidVotes\x128\n" +
+	"\x18average_valid_confidence\x18\x03 \x01(\rR\x16averageValidConfidence\x123\n" +
+	"\x04rule\x18\x04 \x01(\v2\x1f.pickle.workqueue.v1.QuorumRuleR\x04rule\x12<\n" +
+	"\x1aaverage_invalid_confidence\x18\x05 \x01(\rR\x18averageInvalidConfidence\"\xb0\x02\n" +
+	"\tChallenge\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x02 \x01(\tR\n" +
+	"challenger\x12-\n" +
+	"\x04bond\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x04bond\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12'\n" +
+	"\x0foriginal_status\x18\x06 \x01(\tR\x0eoriginalStatus\x12\x1a\n" +
+	"\bresolved\x18\a \x01(\bR\bresolved\x12\x1e\n" +
+	"\n" +
+	"overturned\x18\b \x01(\bR\n" +
+	"overturned\x12\x1f\n" +
+	"\vresolved_at\x18\t \x01(\x03R\n" +
+	"resolvedAt\"\xfb\x01\n" +
+	"\x06Bounty\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1c\n" +
+	"\tsubmitter\x18\x02 \x01(\tR\tsubmitter\x121\n" +
+	"\x06amount\x18\x03 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x121\n" +
+	"\x06burned\x18\x05 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06burned\x12\x1d\n" +
+	"\n" +
+	"settled_at\x18\x06 \x01(\x03R\tsettledAt\x12\x1d\n" +
+	"\n" +
+	"settles_at\x18\a \x01(\x03R\tsettlesAt\"\xa4\x01\n" +
+	"\rValidatorBond\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\x12\x1f\n" +
+	"\vslash_count\x18\x03 \x01(\x04R\n" +
+	"slashCount\x12!\n" +
+	"\fjailed_until\x18\x04 \x01(\x03R\vjailedUntil\"\x8e\x01\n" +
+	"\x0eUnbondingEntry\x12\x1c\n" +
+	"\tvalidator\x18\x01 \x01(\tR\tvalidator\x121\n" +
+	"\x06amount\x18\x02 \x01(\v2\x19.cosmos.base.v1beta1.CoinR\x06amount\x12+\n" +
+	"\x11completion_height\x18\x03 \x01(\x03R\x10completionHeight\"\xc6\x01\n" +
 	"\tWorkQueue\x12@\n" +
 	"\fpending_work\x18\x01 \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\vpendingWork\x12'\n" +
 	"\x0ftotal_submitted\x18\x02 \x01(\x04R\x0etotalSubmitted\x12'\n" +
 	"\x0ftotal_validated\x18\x03 \x01(\x04R\x0etotalValidated\x12%\n" +
-	"\x0etotal_rejected\x18\x04 \x01(\x04R\rtotalRejected\"\x92\x01\n" +
+	"\x0etotal_rejected\x18\x04 \x01(\x04R\rtotalRejected\"\xaf\b\n" +
+	"\x06Params\x12\"\n" +
+	"\rmax_data_size\x18\x01 \x01(\x04R\vmaxDataSize\x124\n" +
+	"\x16default_required_votes\x18\x03 \x01(\rR\x14defaultRequiredVotes\x12<\n" +
+	"\x1adefault_required_agreement\x18\x04 \x01(\rR\x18defaultRequiredAgreement\x12C\n" +
+	"\x1edefault_min_average_confidence\x18\x05 \x01(\rR\x1bdefaultMinAverageConfidence\x12!\n" +
+	"\flease_blocks\x18\x06 \x01(\x03R\vleaseBlocks\x12,\n" +
+	"\x12work_expiry_blocks\x18\a \x01(\x03R\x10workExpiryBlocks\x12)\n" +
+	"\x10challenge_window\x18\b \x01(\x03R\x0fchallengeWindow\x12\x1d\n" +
+	"\n" +
+	"bond_denom\x18\t \x01(\tR\tbondDenom\x12,\n" +
+	"\x12min_challenge_bond\x18\n" +
+	" \x01(\tR\x10minChallengeBond\x12,\n" +
+	"\x12min_validator_bond\x18\v \x01(\tR\x10minValidatorBond\x12)\n" +
+	"\x10unbonding_blocks\x18\f \x01(\x03R\x0funbondingBlocks\x12%\n" +
+	"\x0eslash_fraction\x18\r \x01(\tR\rslashFraction\x12%\n" +
+	"\x0ejail_threshold\x18\x0e \x01(\x04R\rjailThreshold\x12\x1f\n" +
+	"\vjail_blocks\x18\x0f \x01(\x03R\n" +
+	"jailBlocks\x120\n" +
+	"\x14bounty_burn_fraction\x18\x10 \x01(\tR\x12bountyBurnFraction\x12!\n" +
+	"\fmax_priority\x18\x11 \x01(\rR\vmaxPriority\x122\n" +
+	"\x15priority_aging_blocks\x18\x12 \x01(\x03R\x13priorityAgingBlocks\x12-\n" +
+	"\x12assignment_enabled\x18\x13 \x01(\bR\x11assignmentEnabled\x129\n" +
+	"\x19max_assignments_per_block\x18\x14 \x01(\rR\x16maxAssignmentsPerBlock\x12,\n" +
+	"\x12max_validator_load\x18\x15 \x01(\rR\x10maxValidatorLoad\x122\n" +
+	"\x15commit_reveal_enabled\x18\x16 \x01(\bR\x13commitRevealEnabled\x12#\n" +
+	"\rreveal_blocks\x18\x17 \x01(\x03R\frevealBlocks\x12#\n" +
+	"\rcommit_blocks\x18\x18 \x01(\x03R\fcommitBlocksJ\x04\b\x02\x10\x03R\x12allowed_work_types\"\xba\x06\n" +
 	"\fGenesisState\x12=\n" +
 	"\n" +
 	"work_queue\x18\x01 \x01(\v2\x1e.pickle.workqueue.v1.WorkQueueR\tworkQueue\x12C\n" +
 	"\n" +
 	"validators\x18\x02 \x03(\v2#.pickle.workqueue.v1.ValidatorStatsR\n" +
-	"validatorsB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
+	"validators\x12B\n" +
+	"\fquorum_rules\x18\x03 \x03(\v2\x1f.pickle.workqueue.v1.QuorumRuleR\vquorumRules\x128\n" +
+	"\x05bonds\x18\x04 \x03(\v2\".pickle.workqueue.v1.ValidatorBondR\x05bonds\x12A\n" +
+	"\tunbonding\x18\x05 \x03(\v2#.pickle.workqueue.v1.UnbondingEntryR\tunbonding\x127\n" +
+	"\bbounties\x18\x06 \x03(\v2\x1b.pickle.workqueue.v1.BountyR\bbounties\x123\n" +
+	"\x06params\x18\a \x01(\v2\x1b.pickle.workqueue.v1.ParamsR\x06params\x12F\n" +
+	"\n" +
+	"work_types\x18\b \x03(\v2'.pickle.workqueue.v1.WorkTypeDefinitionR\tworkTypes\x12?\n" +
+	"\acommits\x18\t \x03(\v2%.pickle.workqueue.v1.ValidationCommitR\acommits\x121\n" +
+	"\x04work\x18\n" +
+	" \x03(\v2\x1d.pickle.workqueue.v1.WorkUnitR\x04work\x123\n" +
+	"\x05votes\x18\v \x03(\v2\x1d.pickle.workqueue.v1.WorkVoteR\x05votes\x12>\n" +
+	"\n" +
+	"challenges\x18\f \x03(\v2\x1e.pickle.workqueue.v1.ChallengeR\n" +
+	"challenges\x12F\n" +
+	"\x0fchallenge_votes\x18\r \x03(\v2\x1d.pickle.workqueue.v1.WorkVoteR\x0echallengeVotesB-Z+github.com/maco144/pickle/x/workqueue/typesb\x06proto3"
 
 var (
 	file_workqueue_v1_workqueue_proto_rawDescOnce sync.Once
@@ -409,24 +1785,53 @@ func file_workqueue_v1_workqueue_proto_rawDescGZIP() []byte {
 	return file_workqueue_v1_workqueue_proto_rawDescData
 }
 
-var file_workqueue_v1_workqueue_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_workqueue_v1_workqueue_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_workqueue_v1_workqueue_proto_goTypes = []any{
-	(*WorkUnit)(nil),       // 0: pickle.workqueue.v1.WorkUnit
-	(*ValidatorStats)(nil), // 1: pickle.workqueue.v1.ValidatorStats
-	(*WorkQueue)(nil),      // 2: pickle.workqueue.v1.WorkQueue
-	(*GenesisState)(nil),   // 3: pickle.workqueue.v1.GenesisState
-	nil,                    // 4: pickle.workqueue.v1.ValidatorStats.SpecializationsEntry
+	(*WorkUnit)(nil),           // 0: pickle.workqueue.v1.WorkUnit
+	(*ValidatorStats)(nil),     // 1: pickle.workqueue.v1.ValidatorStats
+	(*WorkVote)(nil),           // 2: pickle.workqueue.v1.WorkVote
+	(*ValidationCommit)(nil),   // 3: pickle.workqueue.v1.ValidationCommit
+	(*QuorumRule)(nil),         // 4: pickle.workqueue.v1.QuorumRule
+	(*WorkTypeDefinition)(nil), // 5: pickle.workqueue.v1.WorkTypeDefinition
+	(*VoteTally)(nil),          // 6: pickle.workqueue.v1.VoteTally
+	(*Challenge)(nil),          // 7: pickle.workqueue.v1.Challenge
+	(*Bounty)(nil),             // 8: pickle.workqueue.v1.Bounty
+	(*ValidatorBond)(nil),      // 9: pickle.workqueue.v1.ValidatorBond
+	(*UnbondingEntry)(nil),     // 10: pickle.workqueue.v1.UnbondingEntry
+	(*WorkQueue)(nil),          // 11: pickle.workqueue.v1.WorkQueue
+	(*Params)(nil),             // 12: pickle.workqueue.v1.Params
+	(*GenesisState)(nil),       // 13: pickle.workqueue.v1.GenesisState
+	nil,                        // 14: pickle.workqueue.v1.ValidatorStats.SpecializationsEntry
+	(*v1beta1.Coin)(nil),       // 15: cosmos.base.v1beta1.Coin
 }
 var file_workqueue_v1_workqueue_proto_depIdxs = []int32{
-	4, // 0: pickle.workqueue.v1.ValidatorStats.specializations:type_name -> pickle.workqueue.v1.ValidatorStats.SpecializationsEntry
-	0, // 1: pickle.workqueue.v1.WorkQueue.pending_work:type_name -> pickle.workqueue.v1.WorkUnit
-	2, // 2: pickle.workqueue.v1.GenesisState.work_queue:type_name -> pickle.workqueue.v1.WorkQueue
-	1, // 3: pickle.workqueue.v1.GenesisState.validators:type_name -> pickle.workqueue.v1.ValidatorStats
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	14, // 0: pickle.workqueue.v1.ValidatorStats.specializations:type_name -> pickle.workqueue.v1.ValidatorStats.SpecializationsEntry
+	4,  // 1: pickle.workqueue.v1.WorkTypeDefinition.quorum_rule:type_name -> pickle.workqueue.v1.QuorumRule
+	4,  // 2: pickle.workqueue.v1.VoteTally.rule:type_name -> pickle.workqueue.v1.QuorumRule
+	15, // 3: pickle.workqueue.v1.Challenge.bond:type_name -> cosmos.base.v1beta1.Coin
+	15, // 4: pickle.workqueue.v1.Bounty.amount:type_name -> cosmos.base.v1beta1.Coin
+	15, // 5: pickle.workqueue.v1.Bounty.burned:type_name -> cosmos.base.v1beta1.Coin
+	15, // 6: pickle.workqueue.v1.ValidatorBond.amount:type_name -> cosmos.base.v1beta1.Coin
+	15, // 7: pickle.workqueue.v1.UnbondingEntry.amount:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 8: pickle.workqueue.v1.WorkQueue.pending_work:type_name -> pickle.workqueue.v1.WorkUnit
+	11, // 9: pickle.workqueue.v1.GenesisState.work_queue:type_name -> pickle.workqueue.v1.WorkQueue
+	1,  // 10: pickle.workqueue.v1.GenesisState.validators:type_name -> pickle.workqueue.v1.ValidatorStats
+	4,  // 11: pickle.workqueue.v1.GenesisState.quorum_rules:type_name -> pickle.workqueue.v1.QuorumRule
+	9,  // 12: pickle.workqueue.v1.GenesisState.bonds:type_name -> pickle.workqueue.v1.ValidatorBond
+	10, // 13: pickle.workqueue.v1.GenesisState.unbonding:type_name -> pickle.workqueue.v1.UnbondingEntry
+	8,  // 14: pickle.workqueue.v1.GenesisState.bounties:type_name -> pickle.workqueue.v1.Bounty
+	12, // 15: pickle.workqueue.v1.GenesisState.params:type_name -> pickle.workqueue.v1.Params
+	5,  // 16: pickle.workqueue.v1.GenesisState.work_types:type_name -> pickle.workqueue.v1.WorkTypeDefinition
+	3,  // 17: pickle.workqueue.v1.GenesisState.commits:type_name -> pickle.workqueue.v1.ValidationCommit
+	0,  // 18: pickle.workqueue.v1.GenesisState.work:type_name -> pickle.workqueue.v1.WorkUnit
+	2,  // 19: pickle.workqueue.v1.GenesisState.votes:type_name -> pickle.workqueue.v1.WorkVote
+	7,  // 20: pickle.workqueue.v1.GenesisState.challenges:type_name -> pickle.workqueue.v1.Challenge
+	2,  // 21: pickle.workqueue.v1.GenesisState.challenge_votes:type_name -> pickle.workqueue.v1.WorkVote
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_workqueue_v1_workqueue_proto_init() }
@@ -440,7 +1845,7 @@ func file_workqueue_v1_workqueue_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workqueue_v1_workqueue_proto_rawDesc), len(file_workqueue_v1_workqueue_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
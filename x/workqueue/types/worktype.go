@@ -0,0 +1,65 @@
+package types
+
+import "fmt"
+
+// DefaultWorkTypes returns the work types registered at genesis by default.
+// They are enabled, accept any data and use the default quorum rule.
+func DefaultWorkTypes() []*WorkTypeDefinition {
+	return []*WorkTypeDefinition{
+		{Name: WorkTypeCrypto, Enabled: true},
+		{Name: WorkTypeMLData, Enabled: true},
+		{Name: WorkTypeSupplyChain, Enabled: true},
+	}
+}
+
+// Validate checks that a work type definition is well formed
+func (d *WorkTypeDefinition) Validate() error {
+	if d == nil {
+		return fmt.Errorf("work type definition cannot be empty")
+	}
+	if d.Name == "" {
+		return fmt.Errorf("work type name cannot be empty")
+	}
+	if err := ValidateKeyComponent(d.Name, MaxIndexedFieldLength); err != nil {
+		return fmt.Errorf("work type name %w", err)
+	}
+
+	if d.QuorumRule != nil {
+		if d.QuorumRule.WorkType != d.Name {
+			return fmt.Errorf("quorum rule for %s does not match work type %s", d.QuorumRule.WorkType, d.Name)
+		}
+		if err := d.QuorumRule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if d.Schema != "" {
+		if _, err := ParseSchema(d.Schema); err != nil {
+			return fmt.Errorf("invalid schema for work type %s: %w", d.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// MaxDataSizeWithin returns the data size limit for the work type, which is
+// the tighter of its own limit and the module-wide limit. Zero means no limit.
+func (d *WorkTypeDefinition) MaxDataSizeWithin(moduleMax uint64) uint64 {
+	if d.MaxDataSize > 0 && (moduleMax == 0 || d.MaxDataSize < moduleMax) {
+		return d.MaxDataSize
+	}
+	return moduleMax
+}
+
+// ValidateData checks submitted work data against the definition's schema
+func (d *WorkTypeDefinition) ValidateData(data []byte) error {
+	if d.Schema == "" {
+		return nil
+	}
+
+	schema, err := ParseSchema(d.Schema)
+	if err != nil {
+		return err
+	}
+	return schema.Validate(data)
+}